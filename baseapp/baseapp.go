@@ -18,6 +18,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/store"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -51,6 +52,22 @@ type (
 	StoreLoader func(ms sdk.CommitMultiStore) error
 )
 
+// String implements the Stringer interface.
+func (m runTxMode) String() string {
+	switch m {
+	case runTxModeCheck:
+		return "check"
+	case runTxModeReCheck:
+		return "recheck"
+	case runTxModeSimulate:
+		return "simulate"
+	case runTxModeDeliver:
+		return "deliver"
+	default:
+		return "unknown"
+	}
+}
+
 // BaseApp reflects the ABCI application implementation.
 type BaseApp struct { // nolint: maligned
 	// initialized on creation
@@ -74,6 +91,10 @@ type BaseApp struct { // nolint: maligned
 	idPeerFilter   sdk.PeerFilter   // filter peers by node ID
 	fauxMerkleMode bool             // if true, IAVL MountStores uses MountStoresDB for simulation speed.
 
+	circuitBreaker sdk.CircuitBreaker // decides which messages may be dispatched
+
+	streamingServices []StreamingService // services streaming committed state changes
+
 	// volatile states:
 	//
 	// checkState is set on InitChain and reset on Commit
@@ -203,12 +224,14 @@ func (app *BaseApp) MountTransientStores(keys map[string]*sdk.TransientStoreKey)
 // multistore, using a specified DB.
 func (app *BaseApp) MountStoreWithDB(key sdk.StoreKey, typ sdk.StoreType, db dbm.DB) {
 	app.cms.MountStoreWithDB(key, typ, db)
+	app.registerStreamingListeners(key, typ)
 }
 
 // MountStore mounts a store to the provided key in the BaseApp multistore,
 // using the default DB.
 func (app *BaseApp) MountStore(key sdk.StoreKey, typ sdk.StoreType) {
 	app.cms.MountStoreWithDB(key, typ, nil)
+	app.registerStreamingListeners(key, typ)
 }
 
 // LoadLatestVersion loads the latest application version. It will panic if
@@ -354,6 +377,10 @@ func (app *BaseApp) setInterBlockCache(cache sdk.MultiStorePersistentCache) {
 	app.interBlockCache = cache
 }
 
+func (app *BaseApp) setStreamingService(s StreamingService) {
+	app.streamingServices = append(app.streamingServices, s)
+}
+
 // Router returns the router of the BaseApp.
 func (app *BaseApp) Router() sdk.Router {
 	if app.sealed {
@@ -614,6 +641,9 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (gInfo sdk.
 		gasWanted = ctx.GasMeter().Limit()
 
 		if err != nil {
+			telemetry.IncrCounterWithLabels(
+				[]string{"tx", "ante", "failed"}, 1, []telemetry.Label{telemetry.NewLabel("mode", mode.String())},
+			)
 			return gInfo, nil, err
 		}
 
@@ -648,9 +678,14 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (*s
 
 	// NOTE: GasWanted is determined by the AnteHandler and GasUsed by the GasMeter.
 	for i, msg := range msgs {
+		// reject disabled messages before they enter the mempool
+		if app.circuitBreaker != nil && !app.circuitBreaker.IsAllowed(ctx, msg) {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrMsgDisabled, "%s/%s; message index: %d", msg.Route(), msg.Type(), i)
+		}
+
 		// skip actual execution for (Re)CheckTx mode
 		if mode == runTxModeCheck || mode == runTxModeReCheck {
-			break
+			continue
 		}
 
 		msgRoute := msg.Route()
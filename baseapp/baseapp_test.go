@@ -3,6 +3,7 @@ package baseapp
 import (
 	"bytes"
 	"encoding/binary"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"os"
@@ -18,6 +19,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	"github.com/cosmos/cosmos-sdk/store/streaming"
 	store "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -502,6 +504,9 @@ func TestBaseAppOptionSeal(t *testing.T) {
 	require.Panics(t, func() {
 		app.SetRouter(NewRouter())
 	})
+	require.Panics(t, func() {
+		app.SetCircuitBreaker(nil)
+	})
 }
 
 func TestSetMinGasPrices(t *testing.T) {
@@ -849,6 +854,123 @@ func TestDeliverTx(t *testing.T) {
 	}
 }
 
+// testCircuitBreaker disables all messages of the given routes.
+type testCircuitBreaker map[string]bool
+
+func (cb testCircuitBreaker) IsAllowed(_ sdk.Context, msg sdk.Msg) bool {
+	return !cb[msg.Route()]
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	deliverKey := []byte("deliver-key")
+	deliverKey2 := []byte("deliver-key2")
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(routeMsgCounter, handlerMsgCounter(t, capKey1, deliverKey))
+		bapp.Router().AddRoute(routeMsgCounter2, handlerMsgCounter(t, capKey1, deliverKey2))
+	}
+	circuitOpt := func(bapp *BaseApp) {
+		bapp.SetCircuitBreaker(testCircuitBreaker{routeMsgCounter2: true})
+	}
+
+	app := setupBaseApp(t, routerOpt, circuitOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	codec := codec.New()
+	registerTestCodec(codec)
+
+	tx := newTxCounter(0, 0)
+	txBytes, err := codec.MarshalBinaryLengthPrefixed(tx)
+	require.NoError(t, err)
+
+	disabledTx := newTxCounter(1, 1)
+	disabledTx.Msgs = append(disabledTx.Msgs, msgCounter2{0})
+	disabledTxBytes, err := codec.MarshalBinaryLengthPrefixed(disabledTx)
+	require.NoError(t, err)
+
+	// disabled messages are rejected in CheckTx
+	checkRes := app.CheckTx(abci.RequestCheckTx{Tx: txBytes})
+	require.True(t, checkRes.IsOK(), fmt.Sprintf("%v", checkRes))
+
+	checkRes = app.CheckTx(abci.RequestCheckTx{Tx: disabledTxBytes})
+	require.False(t, checkRes.IsOK())
+	require.Equal(t, sdkerrors.ErrMsgDisabled.ABCICode(), checkRes.Code)
+
+	// and in DeliverTx, where the whole tx is reverted
+	header := abci.Header{Height: 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+
+	res = app.DeliverTx(abci.RequestDeliverTx{Tx: disabledTxBytes})
+	require.False(t, res.IsOK())
+	require.Equal(t, sdkerrors.ErrMsgDisabled.ABCICode(), res.Code)
+
+	store := app.deliverState.ctx.KVStore(capKey1)
+	require.Equal(t, int64(1), getIntFromStore(store, deliverKey))
+	require.Equal(t, int64(0), getIntFromStore(store, deliverKey2))
+
+	// handlers returned by the router check the circuit breaker as well
+	_, err = app.router.Route(app.deliverState.ctx, routeMsgCounter2)(app.deliverState.ctx, msgCounter2{0})
+	require.True(t, sdkerrors.ErrMsgDisabled.Is(err))
+}
+
+func TestStreamingService(t *testing.T) {
+	anteKey := []byte("ante-key")
+	deliverKey := []byte("deliver-key")
+
+	var buf bytes.Buffer
+	ss := streaming.NewWriterService(&buf, []string{capKey1.Name()})
+
+	anteOpt := func(bapp *BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey)) }
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(routeMsgCounter, handlerMsgCounter(t, capKey1, deliverKey))
+	}
+
+	app := setupBaseApp(t, SetStreamingService(ss), anteOpt, routerOpt)
+	require.True(t, app.cms.ListeningEnabled(capKey1))
+	require.False(t, app.cms.ListeningEnabled(capKey2))
+
+	app.InitChain(abci.RequestInitChain{})
+
+	codec := codec.New()
+	registerTestCodec(codec)
+
+	txBytes, err := codec.MarshalBinaryLengthPrefixed(newTxCounter(0, 0))
+	require.NoError(t, err)
+
+	// CheckTx state changes are never streamed
+	checkRes := app.CheckTx(abci.RequestCheckTx{Tx: txBytes})
+	require.True(t, checkRes.IsOK(), fmt.Sprintf("%v", checkRes))
+
+	header := abci.Header{Height: 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+
+	app.EndBlock(abci.RequestEndBlock{})
+
+	// state changes are only streamed once the block is committed
+	require.Zero(t, buf.Len())
+	commitRes := app.Commit()
+
+	var changes streaming.BlockStateChanges
+	require.NoError(t, json.NewDecoder(&buf).Decode(&changes))
+	require.Equal(t, int64(1), changes.Height)
+	require.Equal(t, commitRes.Data, changes.AppHash)
+
+	written := make(map[string][]byte)
+	for _, pair := range changes.Changes {
+		require.Equal(t, capKey1.Name(), pair.StoreKey)
+		written[string(pair.Key)] = pair.Value
+	}
+
+	committed := app.cms.GetKVStore(capKey1)
+	require.Equal(t, committed.Get(anteKey), written[string(anteKey)])
+	require.Equal(t, committed.Get(deliverKey), written[string(deliverKey)])
+}
+
 // Number of messages doesn't matter to CheckTx.
 func TestMultiMsgCheckTx(t *testing.T) {
 	// TODO: ensure we get the same results
@@ -44,6 +44,14 @@ func SetInterBlockCache(cache sdk.MultiStorePersistentCache) func(*BaseApp) {
 	return func(app *BaseApp) { app.setInterBlockCache(cache) }
 }
 
+// SetStreamingService provides a BaseApp option function that registers a
+// service streaming the state changes of committed blocks. The option must be
+// passed to NewBaseApp, as the service's listeners are registered when the
+// stores are mounted.
+func SetStreamingService(s StreamingService) func(*BaseApp) {
+	return func(app *BaseApp) { app.setStreamingService(s) }
+}
+
 func (app *BaseApp) SetName(name string) {
 	if app.sealed {
 		panic("SetName() on sealed BaseApp")
@@ -136,6 +144,22 @@ func (app *BaseApp) SetStoreLoader(loader StoreLoader) {
 	app.storeLoader = loader
 }
 
+// SetCircuitBreaker sets the circuit breaker deciding which messages may be
+// dispatched to their handlers. Messages it does not allow are rejected in
+// both CheckTx and DeliverTx. If the app uses the default Router, messages
+// dispatched by modules through the router are checked as well; custom
+// routers must consult the circuit breaker themselves.
+func (app *BaseApp) SetCircuitBreaker(cb sdk.CircuitBreaker) {
+	if app.sealed {
+		panic("SetCircuitBreaker() on sealed BaseApp")
+	}
+
+	app.circuitBreaker = cb
+	if rtr, ok := app.router.(*Router); ok {
+		rtr.SetCircuitBreaker(cb)
+	}
+}
+
 // SetRouter allows us to customize the router.
 func (app *BaseApp) SetRouter(router sdk.Router) {
 	if app.sealed {
@@ -4,10 +4,12 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 type Router struct {
-	routes map[string]sdk.Handler
+	routes         map[string]sdk.Handler
+	circuitBreaker sdk.CircuitBreaker
 }
 
 var _ sdk.Router = NewRouter()
@@ -33,9 +35,29 @@ func (rtr *Router) AddRoute(path string, h sdk.Handler) sdk.Router {
 	return rtr
 }
 
-// Route returns a handler for a given route path.
+// SetCircuitBreaker sets the circuit breaker consulted by the handlers
+// returned from Route, so that messages dispatched by modules through the
+// router, e.g. on behalf of another account, cannot bypass it.
+func (rtr *Router) SetCircuitBreaker(cb sdk.CircuitBreaker) {
+	rtr.circuitBreaker = cb
+}
+
+// Route returns a handler for a given route path. If a circuit breaker is set,
+// the handler rejects messages the circuit breaker does not allow.
 //
 // TODO: Handle expressive matches.
 func (rtr *Router) Route(_ sdk.Context, path string) sdk.Handler {
-	return rtr.routes[path]
+	h := rtr.routes[path]
+	if h == nil || rtr.circuitBreaker == nil {
+		return h
+	}
+
+	cb := rtr.circuitBreaker
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		if !cb.IsAllowed(ctx, msg) {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrMsgDisabled, "%s/%s", msg.Route(), msg.Type())
+		}
+
+		return h(ctx, msg)
+	}
 }
@@ -0,0 +1,50 @@
+package baseapp
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// StreamingService defines a service streaming the state changes of committed
+// blocks to an external sink, e.g. an indexer.
+//
+// A StreamingService is registered on the BaseApp through the
+// SetStreamingService option. The WriteListeners it returns are notified of
+// every write flushed to the corresponding KVStore, which happens once per
+// block when the DeliverTx state is written at Commit. ListenCommit is called
+// after the block has been committed, allowing the service to emit the
+// accumulated state changes under the committed height.
+type StreamingService interface {
+	// Listeners returns the WriteListeners to register on the KVStore mounted
+	// under the given key. Returning no listeners leaves the store unobserved.
+	Listeners(key sdk.StoreKey) []sdk.WriteListener
+
+	// ListenCommit is called once a block has been committed.
+	ListenCommit(header abci.Header, commitID sdk.CommitID) error
+}
+
+// registerStreamingListeners registers the listeners of all streaming
+// services on the store mounted under the given key. Transient stores are
+// reset every block and never observed.
+func (app *BaseApp) registerStreamingListeners(key sdk.StoreKey, typ sdk.StoreType) {
+	if typ == sdk.StoreTypeTransient {
+		return
+	}
+
+	for _, s := range app.streamingServices {
+		if listeners := s.Listeners(key); len(listeners) != 0 {
+			app.cms.AddListeners(key, listeners)
+		}
+	}
+}
+
+// listenCommit notifies all streaming services of a committed block. A service
+// failing to process the block is logged but does not affect consensus.
+func (app *BaseApp) listenCommit(header abci.Header, commitID sdk.CommitID) {
+	for _, s := range app.streamingServices {
+		if err := s.ListenCommit(header, commitID); err != nil {
+			app.logger.Error("failed to stream committed state changes", "height", header.Height, "err", err)
+		}
+	}
+}
@@ -1,5 +1,15 @@
 package client
 
+import (
+	"encoding/base64"
+	"errors"
+
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
 // Paginate returns the correct starting and ending index for a paginated query,
 // given that client provides a desired page and limit of objects and the handler
 // provides the total number of objects. If the start page is invalid, non-positive
@@ -28,3 +38,33 @@ func Paginate(numObjs, page, limit, defLimit int) (start, end int) {
 
 	return start, end
 }
+
+// ReadPageRequest reads the pagination flags added by
+// flags.AddPaginationFlagsToCmd and returns the corresponding PageRequest. The
+// page key is expected to be base64 encoded, as returned in the next_key of a
+// PageResponse.
+func ReadPageRequest() (*query.PageRequest, error) {
+	key, err := base64.StdEncoding.DecodeString(viper.GetString(flags.FlagPageKey))
+	if err != nil {
+		return nil, errors.New("invalid page key; must be base64 encoded")
+	}
+
+	offset := viper.GetUint64(flags.FlagOffset)
+	limit := viper.GetUint64(flags.FlagLimit)
+	page := viper.GetUint64(flags.FlagPage)
+
+	if page > 1 && offset > 0 {
+		return nil, errors.New("page and offset cannot be used together")
+	}
+
+	if page > 1 {
+		offset = (page - 1) * limit
+	}
+
+	req := query.NewPageRequest(key, offset, limit, viper.GetBool(flags.FlagCountTotal))
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
@@ -15,6 +15,8 @@ require (
 	github.com/mattn/go-isatty v0.0.12
 	github.com/pelletier/go-toml v1.6.0
 	github.com/pkg/errors v0.9.1
+	github.com/prometheus/client_golang v0.9.3
+	github.com/prometheus/common v0.4.0
 	github.com/rakyll/statik v0.1.6
 	github.com/regen-network/cosmos-proto v0.1.0
 	github.com/spf13/afero v1.2.1 // indirect
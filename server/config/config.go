@@ -5,6 +5,8 @@ import (
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/store/cache"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -35,12 +37,32 @@ type BaseConfig struct {
 	// InterBlockCache enables inter-block caching.
 	InterBlockCache bool `mapstructure:"inter-block-cache"`
 
-	Pruning string `mapstructure:"pruning"`
+	// InterBlockCacheSize defines the maximum number of entries each per-store
+	// inter-block cache holds.
+	InterBlockCacheSize uint `mapstructure:"inter-block-cache-size"`
+
+	// Pruning sets the pruning strategy of the multistore. When set to custom,
+	// PruningKeepRecent, PruningKeepEvery, PruningSnapshotEvery and
+	// PruningInterval are used.
+	Pruning              string `mapstructure:"pruning"`
+	PruningKeepRecent    int64  `mapstructure:"pruning-keep-recent"`
+	PruningKeepEvery     int64  `mapstructure:"pruning-keep-every"`
+	PruningSnapshotEvery int64  `mapstructure:"pruning-snapshot-every"`
+	PruningInterval      int64  `mapstructure:"pruning-interval"`
+
+	// StreamingFileDir, when set, enables streaming the state changes of every
+	// committed block to a file in the given directory. StreamingKeys restricts
+	// the streamed state changes to the stores with the given names.
+	StreamingFileDir string   `mapstructure:"streaming-file-dir"`
+	StreamingKeys    []string `mapstructure:"streaming-keys"`
 }
 
 // Config defines the server's top level configuration
 type Config struct {
 	BaseConfig `mapstructure:",squash"`
+
+	// Telemetry defines the application telemetry configuration
+	Telemetry telemetry.Config `mapstructure:"telemetry"`
 }
 
 // SetMinGasPrices sets the validator's minimum gas prices.
@@ -74,9 +96,15 @@ func (c *Config) GetMinGasPrices() sdk.DecCoins {
 func DefaultConfig() *Config {
 	return &Config{
 		BaseConfig{
-			MinGasPrices:    defaultMinGasPrices,
-			InterBlockCache: true,
-			Pruning:         store.PruningStrategySyncable,
+			MinGasPrices:        defaultMinGasPrices,
+			InterBlockCache:     true,
+			InterBlockCacheSize: cache.DefaultCommitKVStoreCacheSize,
+			Pruning:             store.PruningStrategySyncable,
+			StreamingKeys:       []string{},
+		},
+		telemetry.Config{
+			Enabled:      false,
+			GlobalLabels: [][]string{},
 		},
 	}
 }
@@ -1,16 +1,23 @@
 package config
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
 
+	"github.com/cosmos/cosmos-sdk/store/cache"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestDefaultConfig(t *testing.T) {
 	cfg := DefaultConfig()
 	require.True(t, cfg.GetMinGasPrices().IsZero())
+	require.True(t, cfg.InterBlockCache)
+	require.Equal(t, cache.DefaultCommitKVStoreCacheSize, cfg.InterBlockCacheSize)
 }
 
 func TestSetMinimumFees(t *testing.T) {
@@ -18,3 +25,55 @@ func TestSetMinimumFees(t *testing.T) {
 	cfg.SetMinGasPrices(sdk.DecCoins{sdk.NewInt64DecCoin("foo", 5)})
 	require.Equal(t, "5.000000000000000000foo", cfg.MinGasPrices)
 }
+
+func TestTelemetryConfig(t *testing.T) {
+	cfg := DefaultConfig()
+	require.False(t, cfg.Telemetry.Enabled)
+
+	cfg.Telemetry.ServiceName = "gaiad"
+	cfg.Telemetry.Enabled = true
+	cfg.Telemetry.GlobalLabels = [][]string{{"chain_id", "test-chain"}}
+
+	dir, err := ioutil.TempDir("", t.Name()+"_")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	file := filepath.Join(dir, "app.toml")
+	WriteConfigFile(file, cfg)
+
+	viper.Reset()
+	defer viper.Reset()
+
+	viper.SetConfigFile(file)
+	require.NoError(t, viper.ReadInConfig())
+
+	parsed, err := ParseConfig()
+	require.NoError(t, err)
+	require.Equal(t, cfg.Telemetry, parsed.Telemetry)
+}
+
+func TestStreamingConfig(t *testing.T) {
+	cfg := DefaultConfig()
+	require.Empty(t, cfg.StreamingFileDir)
+
+	cfg.StreamingFileDir = "/tmp/streaming"
+	cfg.StreamingKeys = []string{"bank", "staking"}
+
+	dir, err := ioutil.TempDir("", t.Name()+"_")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	file := filepath.Join(dir, "app.toml")
+	WriteConfigFile(file, cfg)
+
+	viper.Reset()
+	defer viper.Reset()
+
+	viper.SetConfigFile(file)
+	require.NoError(t, viper.ReadInConfig())
+
+	parsed, err := ParseConfig()
+	require.NoError(t, err)
+	require.Equal(t, cfg.StreamingFileDir, parsed.StreamingFileDir)
+	require.Equal(t, cfg.StreamingKeys, parsed.StreamingKeys)
+}
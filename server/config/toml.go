@@ -34,11 +34,68 @@ halt-time = {{ .BaseConfig.HaltTime }}
 # InterBlockCache enables inter-block caching.
 inter-block-cache = {{ .BaseConfig.InterBlockCache }}
 
-# Pruning sets the pruning strategy: syncable, nothing, everything
+# InterBlockCacheSize defines the maximum number of entries each store's
+# inter-block cache holds before evicting.
+inter-block-cache-size = {{ .BaseConfig.InterBlockCacheSize }}
+
+# Pruning sets the pruning strategy: syncable, nothing, everything, custom
 # syncable: only those states not needed for state syncing will be deleted (keeps last 100 + every 10000th)
 # nothing: all historic states will be saved, nothing will be deleted (i.e. archiving node)
 # everything: all saved states will be deleted, storing only the current state
+# custom: allow pruning options to be manually specified through 'pruning-keep-recent', 'pruning-keep-every',
+# 'pruning-snapshot-every' and 'pruning-interval'
 pruning = "{{ .BaseConfig.Pruning }}"
+
+# These are applied if and only if the pruning strategy is custom.
+# pruning-keep-every defines which committed heights are flushed to disk and
+# pruning-snapshot-every which of these heights are kept after pruning. The
+# snapshot interval must be a multiple of the keep interval (0 disables snapshots).
+# pruning-keep-recent defines how many recent heights are kept in memory in
+# addition to the flushed ones, and pruning-interval every how many heights the
+# pruned heights are deleted in a single batch (0 deletes them immediately).
+pruning-keep-recent = {{ .BaseConfig.PruningKeepRecent }}
+pruning-keep-every = {{ .BaseConfig.PruningKeepEvery }}
+pruning-snapshot-every = {{ .BaseConfig.PruningSnapshotEvery }}
+pruning-interval = {{ .BaseConfig.PruningInterval }}
+
+# StreamingFileDir, when set, enables streaming the state changes of every
+# committed block to a separate file in the given directory.
+streaming-file-dir = "{{ .BaseConfig.StreamingFileDir }}"
+
+# StreamingKeys restricts the streamed state changes to the stores with the
+# given names, e.g. ["bank", "staking"]. All stores are streamed when empty.
+streaming-keys = [{{ range .BaseConfig.StreamingKeys }}"{{ . }}", {{ end }}]
+
+###############################################################################
+###                         Telemetry Configuration                         ###
+###############################################################################
+
+[telemetry]
+
+# Prefixed with keys to separate services.
+service-name = "{{ .Telemetry.ServiceName }}"
+
+# Enabled enables the application telemetry functionality. When enabled,
+# metrics are kept in memory and exposed through Tendermint's Prometheus
+# endpoint, which requires 'instrumentation.prometheus' to be enabled in
+# config.toml.
+enabled = {{ .Telemetry.Enabled }}
+
+# Enable adding the hostname of the node as a "host" label to all metrics.
+enable-hostname-label = {{ .Telemetry.EnableHostnameLabel }}
+
+# PrometheusRetentionTime, when positive, defines how long (in seconds) a
+# metric that is not updated anymore is kept. Zero keeps metrics forever.
+prometheus-retention-time = {{ .Telemetry.PrometheusRetentionTime }}
+
+# GlobalLabels defines a global set of name/value label tuples applied to all
+# metrics emitted by the application.
+#
+# Example:
+# [["chain_id", "cosmoshub-1"]]
+global-labels = [{{ range $k, $v := .Telemetry.GlobalLabels }}
+  ["{{index $v 0 }}", "{{ index $v 1}}"],{{ end }}
+]
 `
 
 var configTemplate *template.Template
@@ -16,7 +16,9 @@ import (
 
 type (
 	// AppCreator is a function that allows us to lazily initialize an
-	// application using various configurations.
+	// application using various configurations. The BaseApp options set
+	// through the start command flags are returned by
+	// GetBaseAppOptionsFromFlags.
 	AppCreator func(log.Logger, dbm.DB, io.Writer) abci.Application
 
 	// AppExporter is a function that dumps all app state to
@@ -0,0 +1,71 @@
+package server
+
+import (
+	"fmt"
+
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetBaseAppOptionsFromFlags parses the start command flags (or their app.toml
+// equivalents) and returns the BaseApp options an application's AppCreator
+// should pass to baseapp.NewBaseApp: the pruning options, minimum gas prices,
+// halt height and time, the inter-block cache if it is enabled and the
+// streaming service if one is configured. NewBaseApp applies the options before
+// the application mounts its stores, which the streaming service must listen
+// to.
+func GetBaseAppOptionsFromFlags() ([]func(*baseapp.BaseApp), error) {
+	if err := validateBaseAppFlags(); err != nil {
+		return nil, err
+	}
+
+	pruningOpts, err := GetPruningOptionsFromFlags()
+	if err != nil {
+		return nil, err
+	}
+
+	minGasPrices := viper.GetString(FlagMinGasPrices)
+
+	opts := []func(*baseapp.BaseApp){
+		baseapp.SetPruning(pruningOpts),
+		baseapp.SetMinGasPrices(minGasPrices),
+		baseapp.SetHaltHeight(viper.GetUint64(FlagHaltHeight)),
+		baseapp.SetHaltTime(viper.GetUint64(FlagHaltTime)),
+	}
+
+	if viper.GetBool(FlagInterBlockCache) {
+		size := viper.GetUint(FlagInterBlockCacheSize)
+		opts = append(opts, baseapp.SetInterBlockCache(store.NewCommitKVStoreCacheManagerWithSize(size)))
+	}
+
+	ss, err := GetStreamingServiceFromFlags()
+	if err != nil {
+		return nil, err
+	}
+	if ss != nil {
+		opts = append(opts, baseapp.SetStreamingService(ss))
+	}
+
+	return opts, nil
+}
+
+// validateBaseAppFlags validates the flags GetBaseAppOptionsFromFlags builds
+// the BaseApp options from, without creating any of them.
+func validateBaseAppFlags() error {
+	if _, err := GetPruningOptionsFromFlags(); err != nil {
+		return err
+	}
+
+	if _, err := sdk.ParseDecCoins(viper.GetString(FlagMinGasPrices)); err != nil {
+		return fmt.Errorf("invalid minimum gas prices: %v", err)
+	}
+
+	if viper.GetBool(FlagInterBlockCache) && viper.GetUint(FlagInterBlockCacheSize) == 0 {
+		return fmt.Errorf("%s must be positive when inter-block caching is enabled", FlagInterBlockCacheSize)
+	}
+
+	return validateStreamingFlags()
+}
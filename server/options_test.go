@@ -0,0 +1,97 @@
+package server
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/store"
+)
+
+func TestGetBaseAppOptionsFromFlags(t *testing.T) {
+	defer viper.Reset()
+
+	viper.Reset()
+	viper.Set(flagPruning, store.PruningStrategyCustom)
+	viper.Set(flagPruningKeepEvery, 10)
+	viper.Set(flagPruningInterval, 100)
+	viper.Set(FlagMinGasPrices, "0.01stake")
+	viper.Set(FlagInterBlockCache, true)
+	viper.Set(FlagInterBlockCacheSize, 100)
+
+	opts, err := GetBaseAppOptionsFromFlags()
+	require.NoError(t, err)
+	require.Len(t, opts, 5)
+
+	app := baseapp.NewBaseApp(t.Name(), log.NewNopLogger(), dbm.NewMemDB(), nil, opts...)
+	require.NotNil(t, app)
+
+	// invalid flags are rejected before the options are applied
+	viper.Set(FlagMinGasPrices, "invalid")
+	_, err = GetBaseAppOptionsFromFlags()
+	require.Error(t, err)
+
+	viper.Set(FlagMinGasPrices, "")
+	viper.Set(FlagInterBlockCacheSize, 0)
+	_, err = GetBaseAppOptionsFromFlags()
+	require.Error(t, err)
+
+	viper.Set(FlagInterBlockCache, false)
+	opts, err = GetBaseAppOptionsFromFlags()
+	require.NoError(t, err)
+	require.Len(t, opts, 4)
+
+	// the streaming service is registered when a streaming directory is set
+	home, err := ioutil.TempDir("", "options")
+	require.NoError(t, err)
+	defer os.RemoveAll(home)
+
+	streamingDir := filepath.Join(home, "streaming")
+	viper.Set(flagStreamingFileDir, streamingDir)
+	opts, err = GetBaseAppOptionsFromFlags()
+	require.NoError(t, err)
+	require.Len(t, opts, 5)
+	require.DirExists(t, streamingDir)
+
+	viper.Set(flagPruningInterval, -1)
+	_, err = GetBaseAppOptionsFromFlags()
+	require.Error(t, err)
+}
+
+func TestValidateBaseAppFlags(t *testing.T) {
+	defer viper.Reset()
+
+	home, err := ioutil.TempDir("", "options")
+	require.NoError(t, err)
+	defer os.RemoveAll(home)
+
+	viper.Reset()
+	viper.Set(flagPruning, store.PruningStrategySyncable)
+	require.NoError(t, validateBaseAppFlags())
+
+	// validating the flags does not create the streaming directory
+	streamingDir := filepath.Join(home, "streaming")
+	viper.Set(flagStreamingFileDir, streamingDir)
+	require.NoError(t, validateBaseAppFlags())
+	_, err = os.Stat(streamingDir)
+	require.True(t, os.IsNotExist(err))
+
+	// the streaming directory cannot be a file
+	streamingFile := filepath.Join(home, "file")
+	require.NoError(t, ioutil.WriteFile(streamingFile, nil, 0600))
+	viper.Set(flagStreamingFileDir, streamingFile)
+	require.Error(t, validateBaseAppFlags())
+	_, err = GetBaseAppOptionsFromFlags()
+	require.Error(t, err)
+
+	viper.Set(flagStreamingFileDir, "")
+	viper.Set(FlagMinGasPrices, "invalid")
+	require.Error(t, validateBaseAppFlags())
+}
@@ -0,0 +1,38 @@
+package server
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/store"
+)
+
+// GetPruningOptionsFromFlags parses the start command flags (or their app.toml
+// equivalents) and returns the PruningOptions an application should set on its
+// multistore via baseapp.SetPruning. An error is returned if the strategy is
+// unknown or if a custom strategy defines invalid intervals.
+func GetPruningOptionsFromFlags() (store.PruningOptions, error) {
+	strategy := strings.ToLower(viper.GetString(flagPruning))
+
+	switch strategy {
+	case store.PruningStrategySyncable, store.PruningStrategyNothing, store.PruningStrategyEverything:
+		return store.NewPruningOptionsFromString(strategy), nil
+
+	case store.PruningStrategyCustom:
+		opts := store.NewPruningOptions(
+			viper.GetInt64(flagPruningKeepRecent), viper.GetInt64(flagPruningKeepEvery),
+			viper.GetInt64(flagPruningSnapshotEvery), viper.GetInt64(flagPruningInterval),
+		)
+
+		if !opts.IsValid() {
+			return opts, fmt.Errorf("invalid custom pruning options: %+v", opts)
+		}
+
+		return opts, nil
+
+	default:
+		return store.PruningOptions{}, fmt.Errorf("unknown pruning strategy %s", strategy)
+	}
+}
@@ -0,0 +1,96 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/store"
+)
+
+func TestGetPruningOptionsFromFlags(t *testing.T) {
+	tests := []struct {
+		name            string
+		initParams      func()
+		expectedOptions store.PruningOptions
+		wantErr         bool
+	}{
+		{
+			name: "pruning nothing",
+			initParams: func() {
+				viper.Set(flagPruning, store.PruningStrategyNothing)
+			},
+			expectedOptions: store.PruneNothing,
+		},
+		{
+			name: "custom pruning options",
+			initParams: func() {
+				viper.Set(flagPruning, store.PruningStrategyCustom)
+				viper.Set(flagPruningKeepEvery, 10)
+				viper.Set(flagPruningSnapshotEvery, 500)
+			},
+			expectedOptions: store.NewPruningOptions(0, 10, 500, 0),
+		},
+		{
+			name: "custom pruning options with keep recent and interval",
+			initParams: func() {
+				viper.Set(flagPruning, store.PruningStrategyCustom)
+				viper.Set(flagPruningKeepRecent, 5)
+				viper.Set(flagPruningKeepEvery, 10)
+				viper.Set(flagPruningSnapshotEvery, 500)
+				viper.Set(flagPruningInterval, 100)
+			},
+			expectedOptions: store.NewPruningOptions(5, 10, 500, 100),
+		},
+		{
+			name: "negative pruning interval",
+			initParams: func() {
+				viper.Set(flagPruning, store.PruningStrategyCustom)
+				viper.Set(flagPruningKeepEvery, 10)
+				viper.Set(flagPruningInterval, -1)
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid custom pruning options",
+			initParams: func() {
+				viper.Set(flagPruning, store.PruningStrategyCustom)
+				viper.Set(flagPruningKeepEvery, 10)
+				viper.Set(flagPruningSnapshotEvery, 15)
+			},
+			wantErr: true,
+		},
+		{
+			name: "unknown pruning strategy",
+			initParams: func() {
+				viper.Set(flagPruning, "foo")
+			},
+			wantErr: true,
+		},
+		{
+			name:            "default",
+			initParams:      func() {},
+			expectedOptions: store.PruneSyncable,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			viper.Reset()
+			viper.SetDefault(flagPruning, store.PruningStrategySyncable)
+			tt.initParams()
+
+			opts, err := GetPruningOptionsFromFlags()
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedOptions, opts)
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"os"
 	"runtime/pprof"
 
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"github.com/tendermint/tendermint/abci/server"
@@ -16,20 +17,31 @@ import (
 	"github.com/tendermint/tendermint/p2p"
 	pvm "github.com/tendermint/tendermint/privval"
 	"github.com/tendermint/tendermint/proxy"
+
+	"github.com/cosmos/cosmos-sdk/server/config"
+	"github.com/cosmos/cosmos-sdk/store/cache"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 )
 
 // Tendermint full-node start flags
 const (
-	flagWithTendermint     = "with-tendermint"
-	flagAddress            = "address"
-	flagTraceStore         = "trace-store"
-	flagPruning            = "pruning"
-	flagCPUProfile         = "cpu-profile"
-	FlagMinGasPrices       = "minimum-gas-prices"
-	FlagHaltHeight         = "halt-height"
-	FlagHaltTime           = "halt-time"
-	FlagInterBlockCache    = "inter-block-cache"
-	FlagUnsafeSkipUpgrades = "unsafe-skip-upgrades"
+	flagWithTendermint       = "with-tendermint"
+	flagAddress              = "address"
+	flagTraceStore           = "trace-store"
+	flagPruning              = "pruning"
+	flagPruningKeepRecent    = "pruning-keep-recent"
+	flagPruningKeepEvery     = "pruning-keep-every"
+	flagPruningSnapshotEvery = "pruning-snapshot-every"
+	flagPruningInterval      = "pruning-interval"
+	flagCPUProfile           = "cpu-profile"
+	FlagMinGasPrices         = "minimum-gas-prices"
+	FlagHaltHeight           = "halt-height"
+	FlagHaltTime             = "halt-time"
+	FlagInterBlockCache      = "inter-block-cache"
+	FlagInterBlockCacheSize  = "inter-block-cache-size"
+	FlagUnsafeSkipUpgrades   = "unsafe-skip-upgrades"
+	flagStreamingFileDir     = "streaming-file-dir"
+	flagStreamingKeys        = "streaming-keys"
 )
 
 // StartCmd runs the service passed in, either stand-alone or in-process with
@@ -46,6 +58,8 @@ Pruning options can be provided via the '--pruning' flag. The options are as fol
 syncable: only those states not needed for state syncing will be deleted (flushes every 100th to disk and keeps every 10000th)
 nothing: all historic states will be saved, nothing will be deleted (i.e. archiving node)
 everything: all saved states will be deleted, storing only the current state
+custom: allow pruning options to be manually specified through 'pruning-keep-recent', 'pruning-keep-every',
+'pruning-snapshot-every' and 'pruning-interval'
 
 Node halting configurations exist in the form of two flags: '--halt-height' and '--halt-time'. During
 the ABCI Commit phase, the node will check if the current block height is greater than or equal to
@@ -53,10 +67,22 @@ the halt-height or if the current block time is greater than or equal to the hal
 node will attempt to gracefully shutdown and the block will not be committed. In addition, the node
 will not be able to commit subsequent blocks.
 
+The state changes of every committed block can be streamed to files in a directory via the
+'--streaming-file-dir' flag, e.g. for indexers. The '--streaming-keys' flag restricts the streamed state
+changes to the stores with the given names. The application must register the streaming service
+on its BaseApp before mounting its stores, e.g. by passing the options of 'server.GetBaseAppOptionsFromFlags'
+to 'baseapp.NewBaseApp', otherwise no state changes are streamed.
+
 For profiling and benchmarking purposes, CPU profiling can be enabled via the '--cpu-profile' flag
 which accepts a path for the resulting pprof file.
 `,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			// the application builds its BaseApp options from the same flags, so
+			// reject invalid ones before starting the node
+			if err := validateBaseAppFlags(); err != nil {
+				return err
+			}
+
 			if !viper.GetBool(flagWithTendermint) {
 				ctx.Logger.Info("starting ABCI without Tendermint")
 				return startStandAlone(ctx, appCreator)
@@ -73,7 +99,11 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Bool(flagWithTendermint, true, "Run abci app embedded in-process with tendermint")
 	cmd.Flags().String(flagAddress, "tcp://0.0.0.0:26658", "Listen address")
 	cmd.Flags().String(flagTraceStore, "", "Enable KVStore tracing to an output file")
-	cmd.Flags().String(flagPruning, "syncable", "Pruning strategy: syncable, nothing, everything")
+	cmd.Flags().String(flagPruning, "syncable", "Pruning strategy: syncable, nothing, everything, custom")
+	cmd.Flags().Int64(flagPruningKeepRecent, 0, "Number of recent committed states kept in memory in addition to the flushed ones (ignored if pruning is not 'custom')")
+	cmd.Flags().Int64(flagPruningKeepEvery, 0, "Flush every n-th committed state to disk (ignored if pruning is not 'custom')")
+	cmd.Flags().Int64(flagPruningSnapshotEvery, 0, "Keep every n-th committed state after pruning, 0 keeps none (ignored if pruning is not 'custom')")
+	cmd.Flags().Int64(flagPruningInterval, 0, "Delete pruned states in a batch every n-th committed state, 0 deletes them immediately (ignored if pruning is not 'custom')")
 	cmd.Flags().String(
 		FlagMinGasPrices, "",
 		"Minimum gas prices to accept for transactions; Any fee in a tx must meet this minimum (e.g. 0.01photino;0.0001stake)",
@@ -82,6 +112,9 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Uint64(FlagHaltHeight, 0, "Block height at which to gracefully halt the chain and shutdown the node")
 	cmd.Flags().Uint64(FlagHaltTime, 0, "Minimum block time (in Unix seconds) at which to gracefully halt the chain and shutdown the node")
 	cmd.Flags().Bool(FlagInterBlockCache, true, "Enable inter-block caching")
+	cmd.Flags().Uint(FlagInterBlockCacheSize, cache.DefaultCommitKVStoreCacheSize, "Maximum number of entries held by each store's inter-block cache")
+	cmd.Flags().String(flagStreamingFileDir, "", "Stream the state changes of every committed block to files in the provided directory")
+	cmd.Flags().StringSlice(flagStreamingKeys, []string{}, "Names of the stores whose state changes are streamed (all stores if empty)")
 	cmd.Flags().String(flagCPUProfile, "", "Enable CPU profiling and write to the provided file")
 
 	// add support for all Tendermint-specific command line options
@@ -144,6 +177,10 @@ func startInProcess(ctx *Context, appCreator AppCreator) (*node.Node, error) {
 		return nil, err
 	}
 
+	if err := startTelemetry(ctx); err != nil {
+		return nil, err
+	}
+
 	app := appCreator(ctx.Logger, db, traceWriter)
 
 	nodeKey, err := p2p.LoadOrGenNodeKey(cfg.NodeKeyFile())
@@ -207,3 +244,28 @@ func startInProcess(ctx *Context, appCreator AppCreator) (*node.Node, error) {
 	// run forever (the node will not be returned)
 	select {}
 }
+
+// startTelemetry sets up the application telemetry if enabled in app.toml. The
+// collected metrics are registered with the default Prometheus registry, which
+// Tendermint serves when its Prometheus instrumentation is enabled.
+func startTelemetry(ctx *Context) error {
+	cfg, err := config.ParseConfig()
+	if err != nil {
+		return err
+	}
+
+	if !cfg.Telemetry.Enabled {
+		return nil
+	}
+
+	metrics, err := telemetry.New(cfg.Telemetry)
+	if err != nil {
+		return err
+	}
+
+	if !ctx.Config.Instrumentation.Prometheus {
+		ctx.Logger.Info("telemetry is enabled without Tendermint's Prometheus instrumentation; metrics are not served")
+	}
+
+	return prometheus.Register(metrics)
+}
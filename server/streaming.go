@@ -0,0 +1,56 @@
+package server
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/store/streaming"
+)
+
+// GetStreamingServiceFromFlags parses the start command flags (or their
+// app.toml equivalents) and returns the StreamingService an application should
+// register on its BaseApp via baseapp.SetStreamingService, creating the
+// streaming directory if needed. A nil service is returned if streaming is
+// disabled.
+//
+// The service only listens to the stores mounted after it is registered, so
+// applications must pass the option to baseapp.NewBaseApp, as
+// GetBaseAppOptionsFromFlags does. Otherwise --streaming-file-dir has no
+// effect.
+func GetStreamingServiceFromFlags() (baseapp.StreamingService, error) {
+	dir := viper.GetString(flagStreamingFileDir)
+	if dir == "" {
+		return nil, nil
+	}
+
+	fs, err := streaming.NewFileService(dir, "", viper.GetStringSlice(flagStreamingKeys))
+	if err != nil {
+		return nil, err
+	}
+
+	return fs, nil
+}
+
+// validateStreamingFlags checks that the streaming directory, if set, can be
+// used without creating it.
+func validateStreamingFlags() error {
+	dir := viper.GetString(flagStreamingFileDir)
+	if dir == "" {
+		return nil
+	}
+
+	fi, err := os.Stat(dir)
+	switch {
+	case os.IsNotExist(err):
+		return nil
+	case err != nil:
+		return err
+	case !fi.IsDir():
+		return fmt.Errorf("%s %s is not a directory", flagStreamingFileDir, dir)
+	}
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package server
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/store/streaming"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func TestGetStreamingServiceFromFlags(t *testing.T) {
+	defer viper.Reset()
+
+	ss, err := GetStreamingServiceFromFlags()
+	require.NoError(t, err)
+	require.Nil(t, ss)
+
+	dir, err := ioutil.TempDir("", "streaming")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	viper.Set(flagStreamingFileDir, dir)
+	viper.Set(flagStreamingKeys, []string{"bank"})
+
+	ss, err = GetStreamingServiceFromFlags()
+	require.NoError(t, err)
+	require.IsType(t, &streaming.FileService{}, ss)
+	require.Len(t, ss.Listeners(types.NewKVStoreKey("bank")), 1)
+	require.Empty(t, ss.Listeners(types.NewKVStoreKey("staking")))
+}
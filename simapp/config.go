@@ -14,6 +14,8 @@ var (
 	FlagExportParamsHeightValue int
 	FlagExportStatePathValue    string
 	FlagExportStatsPathValue    string
+	FlagExportFailurePathValue  string
+	FlagReplayValue             string
 	FlagSeedValue               int64
 	FlagInitialBlockHeightValue int
 	FlagNumBlocksValue          int
@@ -38,6 +40,8 @@ func GetSimulatorFlags() {
 	flag.IntVar(&FlagExportParamsHeightValue, "ExportParamsHeight", 0, "height to which export the randomly generated params")
 	flag.StringVar(&FlagExportStatePathValue, "ExportStatePath", "", "custom file path to save the exported app state JSON")
 	flag.StringVar(&FlagExportStatsPathValue, "ExportStatsPath", "", "custom file path to save the exported simulation statistics JSON")
+	flag.StringVar(&FlagExportFailurePathValue, "ExportFailurePath", "", "custom directory to save the app state, operation log and replay config of a failed simulation")
+	flag.StringVar(&FlagReplayValue, "Replay", "", "replay config file exported by a failed simulation; overrides all other config flags")
 	flag.Int64Var(&FlagSeedValue, "Seed", 42, "simulation random seed")
 	flag.IntVar(&FlagInitialBlockHeightValue, "InitialBlockHeight", 1, "initial block to start the simulation")
 	flag.IntVar(&FlagNumBlocksValue, "NumBlocks", 500, "number of new blocks to simulate from the initial block height")
@@ -55,7 +59,17 @@ func GetSimulatorFlags() {
 }
 
 // NewConfigFromFlags creates a simulation from the retrieved values of the flags.
+// If a replay config file is provided, the config is loaded from it instead.
 func NewConfigFromFlags() simulation.Config {
+	if FlagReplayValue != "" {
+		config, err := simulation.NewReplayConfig(FlagReplayValue)
+		if err != nil {
+			panic(err)
+		}
+
+		return config
+	}
+
 	return simulation.Config{
 		GenesisFile:        FlagGenesisFileValue,
 		ParamsFile:         FlagParamsFileValue,
@@ -63,6 +77,7 @@ func NewConfigFromFlags() simulation.Config {
 		ExportParamsHeight: FlagExportParamsHeightValue,
 		ExportStatePath:    FlagExportStatePathValue,
 		ExportStatsPath:    FlagExportStatsPathValue,
+		ExportFailurePath:  FlagExportFailurePathValue,
 		Seed:               FlagSeedValue,
 		InitialBlockHeight: FlagInitialBlockHeightValue,
 		NumBlocks:          FlagNumBlocksValue,
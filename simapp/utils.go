@@ -69,6 +69,15 @@ func SimulationOperations(app App, cdc *codec.Codec, config simulation.Config) [
 	return app.SimulationManager().WeightedOperations(simState)
 }
 
+// SimulationExportStateFn returns the function used by the simulation to export
+// the app state of a failed simulation.
+func SimulationExportStateFn(app App) simulation.ExportStateFn {
+	return func() (json.RawMessage, error) {
+		appState, _, err := app.ExportAppStateAndValidators(false, nil)
+		return appState, err
+	}
+}
+
 // CheckExportSimulation exports the app state and simulation parameters to JSON
 // if the export paths are defined.
 func CheckExportSimulation(
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io"
 	"sync"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/tendermint/iavl"
@@ -15,6 +16,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/cachekv"
 	"github.com/cosmos/cosmos-sdk/store/tracekv"
 	"github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
@@ -33,6 +35,9 @@ var (
 type Store struct {
 	tree    Tree
 	pruning types.PruningOptions
+
+	// flushed heights pending deletion at the next pruning interval
+	pruneHeights []int64
 }
 
 // LoadStore returns an IAVL Store as a CommitKVStore. Internally, it will load the
@@ -43,26 +48,11 @@ func LoadStore(db dbm.DB, id types.CommitID, pruning types.PruningOptions, lazyL
 		return nil, fmt.Errorf("pruning options are invalid: %v", pruning)
 	}
 
-	var keepRecent int64
-
-	// Determine the value of keepRecent based on the following:
-	//
-	// If KeepEvery = 1, keepRecent should be 0 since there is no need to keep
-	// latest version in a in-memory cache.
-	//
-	// If KeepEvery > 1, keepRecent should be 1 so that state changes in between
-	// flushed states can be saved in the in-memory latest tree.
-	if pruning.KeepEvery == 1 {
-		keepRecent = 0
-	} else {
-		keepRecent = 1
-	}
-
 	tree, err := iavl.NewMutableTreeWithOpts(
 		db,
 		dbm.NewMemDB(),
 		defaultIAVLCacheSize,
-		iavl.PruningOptions(pruning.KeepEvery, keepRecent),
+		iavl.PruningOptions(pruning.KeepEvery, pruning.RecentVersions()),
 	)
 	if err != nil {
 		return nil, err
@@ -79,11 +69,37 @@ func LoadStore(db dbm.DB, id types.CommitID, pruning types.PruningOptions, lazyL
 	}
 
 	return &Store{
-		tree:    tree,
-		pruning: pruning,
+		tree:         tree,
+		pruning:      pruning,
+		pruneHeights: pendingPruneHeights(tree, pruning),
 	}, nil
 }
 
+// pendingPruneHeights returns the flushed heights of a loaded tree that were
+// pending deletion when the node stopped, i.e. the heights superseded within
+// the last pruning interval that are not snapshots.
+func pendingPruneHeights(tree *iavl.MutableTree, pruning types.PruningOptions) []int64 {
+	if pruning.Interval <= 1 {
+		return nil
+	}
+
+	var heights []int64
+
+	latest := tree.Version()
+	for _, v := range tree.AvailableVersions() {
+		ver := int64(v)
+		if ver >= latest || ver < latest-pruning.Interval-pruning.KeepEvery {
+			continue
+		}
+
+		if pruning.FlushVersion(ver) && !pruning.SnapshotVersion(ver) {
+			heights = append(heights, ver)
+		}
+	}
+
+	return heights
+}
+
 // UnsafeNewStore returns a reference to a new IAVL Store with a given mutable
 // IAVL tree reference. It should only be used for testing purposes.
 //
@@ -121,6 +137,8 @@ func (st *Store) GetImmutable(version int64) (*Store, error) {
 // Commit commits the current store state and returns a CommitID with the new
 // version and hash.
 func (st *Store) Commit() types.CommitID {
+	defer telemetry.MeasureSince(time.Now(), "store", "iavl", "commit")
+
 	hash, version, err := st.tree.SaveVersion()
 	if err != nil {
 		// TODO: Do we want to extend Commit to allow returning errors?
@@ -135,11 +153,21 @@ func (st *Store) Commit() types.CommitID {
 		// Previous flushed version should only be pruned if the previous version is
 		// not a snapshot version OR if snapshotting is disabled (SnapshotEvery == 0).
 		if previous != 0 && !st.pruning.SnapshotVersion(previous) {
-			err := st.tree.DeleteVersion(previous)
+			st.pruneHeights = append(st.pruneHeights, previous)
+		}
+	}
+
+	// Delete the superseded versions in a single batch once per pruning
+	// interval instead of on every flush.
+	if len(st.pruneHeights) != 0 && st.pruning.PruneVersion(version) {
+		for _, height := range st.pruneHeights {
+			err := st.tree.DeleteVersion(height)
 			if errCause := errors.Cause(err); errCause != nil && errCause != iavl.ErrVersionDoesNotExist {
 				panic(err)
 			}
 		}
+
+		st.pruneHeights = nil
 	}
 
 	return types.CommitID{
@@ -184,23 +212,27 @@ func (st *Store) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.Ca
 
 // Implements types.KVStore.
 func (st *Store) Set(key, value []byte) {
+	defer telemetry.MeasureSince(time.Now(), "store", "iavl", "set")
 	types.AssertValidValue(value)
 	st.tree.Set(key, value)
 }
 
 // Implements types.KVStore.
 func (st *Store) Get(key []byte) []byte {
+	defer telemetry.MeasureSince(time.Now(), "store", "iavl", "get")
 	_, value := st.tree.Get(key)
 	return value
 }
 
 // Implements types.KVStore.
 func (st *Store) Has(key []byte) (exists bool) {
+	defer telemetry.MeasureSince(time.Now(), "store", "iavl", "has")
 	return st.tree.Has(key)
 }
 
 // Implements types.KVStore.
 func (st *Store) Delete(key []byte) {
+	defer telemetry.MeasureSince(time.Now(), "store", "iavl", "delete")
 	st.tree.Remove(key)
 }
 
@@ -456,6 +456,46 @@ func testPruning(t *testing.T, numRecent int64, storeEvery int64, snapshotEvery
 	}
 }
 
+func TestIAVLPruningInterval(t *testing.T) {
+	db := dbm.NewMemDB()
+	pruningOpts := types.NewPruningOptions(0, 1, 0, 5)
+
+	store, err := LoadStore(db, types.CommitID{}, pruningOpts, false)
+	require.NoError(t, err)
+	iavlStore := store.(*Store)
+
+	// superseded versions are only deleted once per interval
+	for i := 0; i < 4; i++ {
+		nextVersion(iavlStore)
+	}
+	for ver := int64(1); ver <= 4; ver++ {
+		require.True(t, iavlStore.VersionExists(ver), "version %d deleted before the pruning interval", ver)
+	}
+
+	nextVersion(iavlStore)
+	for ver := int64(1); ver <= 4; ver++ {
+		require.False(t, iavlStore.VersionExists(ver), "version %d not pruned at the pruning interval", ver)
+	}
+	require.True(t, iavlStore.VersionExists(5))
+
+	// versions pending deletion when the store is reloaded are still pruned
+	nextVersion(iavlStore)
+	nextVersion(iavlStore)
+
+	store, err = LoadStore(db, iavlStore.LastCommitID(), pruningOpts, false)
+	require.NoError(t, err)
+	iavlStore = store.(*Store)
+	require.Equal(t, []int64{5, 6}, iavlStore.pruneHeights)
+
+	for i := 0; i < 3; i++ {
+		nextVersion(iavlStore)
+	}
+	for ver := int64(5); ver <= 9; ver++ {
+		require.False(t, iavlStore.VersionExists(ver), "version %d not pruned after reloading the store", ver)
+	}
+	require.True(t, iavlStore.VersionExists(10))
+}
+
 func TestIAVLNoPrune(t *testing.T) {
 	db := dbm.NewMemDB()
 	tree, err := iavl.NewMutableTree(db, cacheSize)
@@ -0,0 +1,82 @@
+package listenkv_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/listenkv"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+var testStoreKey = types.NewKVStoreKey("listen_test")
+
+type failingListener struct{}
+
+func (failingListener) OnWrite(types.StoreKey, []byte, []byte, bool) error {
+	return errors.New("listener failure")
+}
+
+func newListenKVStore(listeners ...types.WriteListener) (*listenkv.Store, dbadapter.Store) {
+	parent := dbadapter.Store{DB: dbm.NewMemDB()}
+	return listenkv.NewStore(parent, testStoreKey, listeners), parent
+}
+
+func TestListenKVStoreSetDelete(t *testing.T) {
+	listener := types.NewMemoryListener()
+	store, parent := newListenKVStore(listener)
+
+	store.Set([]byte("key1"), []byte("value1"))
+	store.Set([]byte("key2"), []byte("value2"))
+	store.Delete([]byte("key1"))
+
+	require.Nil(t, parent.Get([]byte("key1")))
+	require.Equal(t, []byte("value2"), parent.Get([]byte("key2")))
+	require.Equal(t, []byte("value2"), store.Get([]byte("key2")))
+	require.True(t, store.Has([]byte("key2")))
+
+	expected := []types.StoreKVPair{
+		{StoreKey: testStoreKey.Name(), Key: []byte("key1"), Value: []byte("value1")},
+		{StoreKey: testStoreKey.Name(), Key: []byte("key2"), Value: []byte("value2")},
+		{StoreKey: testStoreKey.Name(), Delete: true, Key: []byte("key1")},
+	}
+	require.Equal(t, expected, listener.PopStateCache())
+	require.Empty(t, listener.PopStateCache())
+
+	// reads do not notify the listeners
+	iter := store.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		require.Equal(t, []byte("key2"), iter.Key())
+	}
+	iter.Close()
+	require.Empty(t, listener.PopStateCache())
+}
+
+func TestListenKVStoreCacheWrap(t *testing.T) {
+	listener := types.NewMemoryListener()
+	store, parent := newListenKVStore(listener)
+
+	cache := store.CacheWrap().(types.CacheKVStore)
+	cache.Set([]byte("key1"), []byte("value1"))
+	require.Empty(t, listener.PopStateCache())
+
+	// the listeners are notified once the cache is written
+	cache.Write()
+	require.Equal(t, []byte("value1"), parent.Get([]byte("key1")))
+	require.Equal(t, []types.StoreKVPair{
+		{StoreKey: testStoreKey.Name(), Key: []byte("key1"), Value: []byte("value1")},
+	}, listener.PopStateCache())
+}
+
+func TestListenKVStoreListenerError(t *testing.T) {
+	store, _ := newListenKVStore(failingListener{})
+	require.Panics(t, func() { store.Set([]byte("key1"), []byte("value1")) })
+}
+
+func TestListenKVStoreGetStoreType(t *testing.T) {
+	store, _ := newListenKVStore()
+	require.Equal(t, types.StoreTypeDB, store.GetStoreType())
+}
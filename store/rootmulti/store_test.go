@@ -442,6 +442,41 @@ func TestMultiStoreQuery(t *testing.T) {
 	require.Equal(t, v2, qres.Value)
 }
 
+func TestMultiStoreListening(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	key1, key2 := ms.keysByName["store1"], ms.keysByName["store2"]
+	listener := types.NewMemoryListener()
+
+	require.False(t, ms.ListeningEnabled(key1))
+	ms.AddListeners(key1, []types.WriteListener{listener})
+	require.True(t, ms.ListeningEnabled(key1))
+	require.False(t, ms.ListeningEnabled(key2))
+
+	// writes of a cache multi-store are reported once written
+	cms := ms.CacheMultiStore()
+	cms.GetKVStore(key1).Set([]byte("k1"), []byte("v1"))
+	cms.GetKVStore(key2).Set([]byte("k2"), []byte("v2"))
+	require.Empty(t, listener.PopStateCache())
+
+	cms.Write()
+	require.Equal(t, []types.StoreKVPair{
+		{StoreKey: "store1", Key: []byte("k1"), Value: []byte("v1")},
+	}, listener.PopStateCache())
+
+	// writes directly to the KVStore are reported immediately
+	ms.GetKVStore(key1).Delete([]byte("k1"))
+	require.Equal(t, []types.StoreKVPair{
+		{StoreKey: "store1", Delete: true, Key: []byte("k1")},
+	}, listener.PopStateCache())
+
+	// committing the multi-store does not report any writes
+	ms.Commit()
+	require.Empty(t, listener.PopStateCache())
+}
+
 //-----------------------------------------------------------------------
 // utils
 
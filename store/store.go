@@ -13,6 +13,7 @@ const (
 	PruningStrategyNothing    = "nothing"
 	PruningStrategyEverything = "everything"
 	PruningStrategySyncable   = "syncable"
+	PruningStrategyCustom     = "custom"
 )
 
 func NewCommitMultiStore(db dbm.DB) types.CommitMultiStore {
@@ -23,6 +24,16 @@ func NewCommitKVStoreCacheManager() types.MultiStorePersistentCache {
 	return cache.NewCommitKVStoreCacheManager(cache.DefaultCommitKVStoreCacheSize)
 }
 
+// NewCommitKVStoreCacheManagerWithSize returns an inter-block cache manager where
+// each per-StoreKey cache holds at most size entries. It panics if size is zero.
+func NewCommitKVStoreCacheManagerWithSize(size uint) types.MultiStorePersistentCache {
+	if size == 0 {
+		panic("inter-block cache size must be positive")
+	}
+
+	return cache.NewCommitKVStoreCacheManager(size)
+}
+
 func NewPruningOptionsFromString(strategy string) (opt PruningOptions) {
 	switch strategy {
 	case PruningStrategyNothing:
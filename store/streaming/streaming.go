@@ -0,0 +1,144 @@
+package streaming
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// BlockStateChanges is the record a WriterService emits for every committed
+// block.
+type BlockStateChanges struct {
+	Height  int64               `json:"height" yaml:"height"`
+	AppHash []byte              `json:"app_hash" yaml:"app_hash"`
+	Changes []types.StoreKVPair `json:"changes" yaml:"changes"`
+}
+
+// listeningService holds the state shared by the streaming services: the
+// names of the listened stores and the listener accumulating their writes.
+type listeningService struct {
+	storeNames map[string]bool
+	listener   *types.MemoryListener
+}
+
+func newListeningService(storeNames []string) listeningService {
+	names := make(map[string]bool, len(storeNames))
+	for _, name := range storeNames {
+		names[name] = true
+	}
+
+	return listeningService{storeNames: names, listener: types.NewMemoryListener()}
+}
+
+// Listeners returns the service's listener for the KVStore mounted under the
+// given key. If the service was created without any store names, all KVStores
+// are listened to.
+func (ls listeningService) Listeners(key types.StoreKey) []types.WriteListener {
+	if len(ls.storeNames) != 0 && !ls.storeNames[key.Name()] {
+		return nil
+	}
+
+	return []types.WriteListener{ls.listener}
+}
+
+// FileService streams the state changes of every committed block to a
+// separate file in a directory. Each file holds one JSON encoded StoreKVPair
+// per line, in the order the writes were flushed to the stores.
+type FileService struct {
+	listeningService
+
+	writeDir   string
+	filePrefix string
+}
+
+// NewFileService creates a FileService writing to the given directory, which
+// is created if it does not exist. The state changes of the stores with the
+// given names are streamed, or of all KVStores if no names are given.
+func NewFileService(writeDir, filePrefix string, storeNames []string) (*FileService, error) {
+	if err := os.MkdirAll(writeDir, 0755); err != nil {
+		return nil, err
+	}
+
+	return &FileService{
+		listeningService: newListeningService(storeNames),
+		writeDir:         writeDir,
+		filePrefix:       filePrefix,
+	}, nil
+}
+
+// FileName returns the name of the file the state changes of the given height
+// are written to.
+func (fs *FileService) FileName(height int64) string {
+	return filepath.Join(fs.writeDir, fmt.Sprintf("%sblock-%d-state-changes", fs.filePrefix, height))
+}
+
+// ListenCommit writes the state changes of the committed block to its file.
+func (fs *FileService) ListenCommit(header abci.Header, _ types.CommitID) error {
+	f, err := os.OpenFile(fs.FileName(header.Height), os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		// drop the block's changes so they are not attributed to the next block
+		fs.listener.PopStateCache()
+		return err
+	}
+
+	werr := writeKVPairs(f, fs.listener.PopStateCache())
+	if err := f.Close(); err != nil && werr == nil {
+		werr = err
+	}
+
+	return werr
+}
+
+// WriterService streams the state changes of every committed block to an
+// io.Writer, e.g. a socket or pipe consumed by an external service. A single
+// JSON encoded BlockStateChanges record is written per line and block.
+type WriterService struct {
+	listeningService
+
+	writer io.Writer
+}
+
+// NewWriterService creates a WriterService writing to w. The state changes of
+// the stores with the given names are streamed, or of all KVStores if no
+// names are given.
+func NewWriterService(w io.Writer, storeNames []string) *WriterService {
+	return &WriterService{listeningService: newListeningService(storeNames), writer: w}
+}
+
+// ListenCommit writes the state changes of the committed block to the
+// service's writer.
+func (ws *WriterService) ListenCommit(header abci.Header, commitID types.CommitID) error {
+	bz, err := json.Marshal(BlockStateChanges{
+		Height:  header.Height,
+		AppHash: commitID.Hash,
+		Changes: ws.listener.PopStateCache(),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = ws.writer.Write(append(bz, '\n'))
+	return err
+}
+
+// writeKVPairs writes the JSON encoding of every pair as a separate line.
+func writeKVPairs(w io.Writer, pairs []types.StoreKVPair) error {
+	for _, pair := range pairs {
+		bz, err := json.Marshal(pair)
+		if err != nil {
+			return err
+		}
+
+		if _, err := w.Write(append(bz, '\n')); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
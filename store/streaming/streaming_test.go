@@ -0,0 +1,98 @@
+package streaming_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/store/streaming"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+var (
+	bankKey    = types.NewKVStoreKey("bank")
+	stakingKey = types.NewKVStoreKey("staking")
+)
+
+func TestListenersStoreNames(t *testing.T) {
+	ws := streaming.NewWriterService(&bytes.Buffer{}, []string{"bank"})
+	require.Len(t, ws.Listeners(bankKey), 1)
+	require.Empty(t, ws.Listeners(stakingKey))
+
+	// all stores are listened to if no names are given
+	ws = streaming.NewWriterService(&bytes.Buffer{}, nil)
+	require.Len(t, ws.Listeners(bankKey), 1)
+	require.Len(t, ws.Listeners(stakingKey), 1)
+}
+
+func TestFileService(t *testing.T) {
+	dir, err := ioutil.TempDir("", "streaming")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	writeDir := filepath.Join(dir, "changes")
+	fs, err := streaming.NewFileService(writeDir, "test-", nil)
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(writeDir, "test-block-5-state-changes"), fs.FileName(5))
+
+	listener := fs.Listeners(bankKey)[0]
+	require.NoError(t, listener.OnWrite(bankKey, []byte("k1"), []byte("v1"), false))
+	require.NoError(t, listener.OnWrite(stakingKey, []byte("k2"), nil, true))
+	require.NoError(t, fs.ListenCommit(abci.Header{Height: 5}, types.CommitID{Version: 5}))
+
+	bz, err := ioutil.ReadFile(fs.FileName(5))
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(string(bz)), "\n")
+	require.Len(t, lines, 2)
+
+	var pairs []types.StoreKVPair
+	for _, line := range lines {
+		var pair types.StoreKVPair
+		require.NoError(t, json.Unmarshal([]byte(line), &pair))
+		pairs = append(pairs, pair)
+	}
+
+	require.Equal(t, []types.StoreKVPair{
+		{StoreKey: "bank", Key: []byte("k1"), Value: []byte("v1")},
+		{StoreKey: "staking", Delete: true, Key: []byte("k2")},
+	}, pairs)
+
+	// a block without state changes yields an empty file
+	require.NoError(t, fs.ListenCommit(abci.Header{Height: 6}, types.CommitID{Version: 6}))
+	bz, err = ioutil.ReadFile(fs.FileName(6))
+	require.NoError(t, err)
+	require.Empty(t, bz)
+}
+
+func TestWriterService(t *testing.T) {
+	var buf bytes.Buffer
+	ws := streaming.NewWriterService(&buf, nil)
+
+	listener := ws.Listeners(bankKey)[0]
+	require.NoError(t, listener.OnWrite(bankKey, []byte("k1"), []byte("v1"), false))
+	require.NoError(t, ws.ListenCommit(abci.Header{Height: 1}, types.CommitID{Version: 1, Hash: []byte("hash1")}))
+	require.NoError(t, ws.ListenCommit(abci.Header{Height: 2}, types.CommitID{Version: 2, Hash: []byte("hash2")}))
+
+	dec := json.NewDecoder(&buf)
+
+	var changes streaming.BlockStateChanges
+	require.NoError(t, dec.Decode(&changes))
+	require.Equal(t, streaming.BlockStateChanges{
+		Height:  1,
+		AppHash: []byte("hash1"),
+		Changes: []types.StoreKVPair{{StoreKey: "bank", Key: []byte("k1"), Value: []byte("v1")}},
+	}, changes)
+
+	changes = streaming.BlockStateChanges{}
+	require.NoError(t, dec.Decode(&changes))
+	require.Equal(t, int64(2), changes.Height)
+	require.Empty(t, changes.Changes)
+}
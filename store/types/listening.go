@@ -0,0 +1,50 @@
+package types
+
+// WriteListener interface for streaming data out from a listenkv.Store
+type WriteListener interface {
+	// OnWrite is called for every KVStore Set and Delete operation that is
+	// flushed to a listened store. When delete is true the value is nil.
+	OnWrite(storeKey StoreKey, key []byte, value []byte, delete bool) error
+}
+
+// StoreKVPair is a single key-value pair written to, or deleted from, a
+// KVStore identified by its name.
+type StoreKVPair struct {
+	StoreKey string `json:"store_key" yaml:"store_key"`
+	Delete   bool   `json:"delete" yaml:"delete"`
+	Key      []byte `json:"key" yaml:"key"`
+	Value    []byte `json:"value" yaml:"value"`
+}
+
+// MemoryListener listens to the state writes and accumulates the records in
+// memory until they are popped.
+type MemoryListener struct {
+	stateCache []StoreKVPair
+}
+
+var _ WriteListener = (*MemoryListener)(nil)
+
+// NewMemoryListener creates a listener that accumulates the state writes in
+// memory.
+func NewMemoryListener() *MemoryListener {
+	return &MemoryListener{}
+}
+
+// OnWrite implements the WriteListener interface
+func (fl *MemoryListener) OnWrite(storeKey StoreKey, key []byte, value []byte, delete bool) error {
+	fl.stateCache = append(fl.stateCache, StoreKVPair{
+		StoreKey: storeKey.Name(),
+		Delete:   delete,
+		Key:      key,
+		Value:    value,
+	})
+
+	return nil
+}
+
+// PopStateCache returns the current state caches and resets them to nil
+func (fl *MemoryListener) PopStateCache() []StoreKVPair {
+	res := fl.stateCache
+	fl.stateCache = nil
+	return res
+}
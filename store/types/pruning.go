@@ -28,9 +28,30 @@ var (
 // will use when committing state, where keepEvery determines which committed
 // heights are flushed to disk and snapshotEvery determines which of these heights
 // are kept after pruning.
+//
+// KeepRecent defines the number of recent heights kept in memory, and thus
+// queryable, in addition to the flushed heights. When zero, the latest height
+// is kept if KeepEvery > 1. Interval defines every how many heights the
+// flushed heights that are not snapshotted are deleted in a single batch,
+// so that deletions do not slow down every commit. When zero, they are
+// deleted as soon as they are superseded.
 type PruningOptions struct {
+	KeepRecent    int64
 	KeepEvery     int64
 	SnapshotEvery int64
+	Interval      int64
+}
+
+// NewPruningOptions returns a new PruningOptions instance with the given
+// keepRecent, keepEvery, snapshotEvery and interval values. Note, the
+// returned options must be validated via IsValid before being used.
+func NewPruningOptions(keepRecent, keepEvery, snapshotEvery, interval int64) PruningOptions {
+	return PruningOptions{
+		KeepRecent:    keepRecent,
+		KeepEvery:     keepEvery,
+		SnapshotEvery: snapshotEvery,
+		Interval:      interval,
+	}
 }
 
 // IsValid verifies if the pruning options are valid. It returns false if invalid
@@ -39,6 +60,8 @@ type PruningOptions struct {
 // - KeepEvery > 0
 // - SnapshotEvery >= 0
 // - SnapshotEvery % KeepEvery = 0
+// - KeepRecent >= 0
+// - Interval >= 0
 func (po PruningOptions) IsValid() bool {
 	// must flush at positive block interval
 	if po.KeepEvery <= 0 {
@@ -50,6 +73,11 @@ func (po PruningOptions) IsValid() bool {
 		return false
 	}
 
+	// cannot keep or prune negative amounts of heights
+	if po.KeepRecent < 0 || po.Interval < 0 {
+		return false
+	}
+
 	return po.SnapshotEvery%po.KeepEvery == 0
 }
 
@@ -64,3 +92,25 @@ func (po PruningOptions) FlushVersion(ver int64) bool {
 func (po PruningOptions) SnapshotVersion(ver int64) bool {
 	return po.SnapshotEvery != 0 && ver%po.SnapshotEvery == 0
 }
+
+// RecentVersions returns the number of recent heights kept in memory.
+func (po PruningOptions) RecentVersions() int64 {
+	switch {
+	case po.KeepRecent > 0:
+		return po.KeepRecent
+
+	case po.KeepEvery > 1:
+		// keep the latest height so that state changes in between flushed
+		// heights can be saved in the in-memory latest tree
+		return 1
+
+	default:
+		return 0
+	}
+}
+
+// PruneVersion returns a boolean signaling if the pending deletions of
+// superseded flushed heights should be executed at the provided version/height.
+func (po PruningOptions) PruneVersion(ver int64) bool {
+	return po.Interval <= 1 || ver%po.Interval == 0
+}
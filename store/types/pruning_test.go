@@ -0,0 +1,42 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPruningOptionsIsValid(t *testing.T) {
+	testCases := []struct {
+		opts  PruningOptions
+		valid bool
+	}{
+		{PruneNothing, true},
+		{PruneEverything, true},
+		{PruneSyncable, true},
+		{NewPruningOptions(5, 10, 100, 50), true},
+		{NewPruningOptions(0, 0, 0, 0), false},
+		{NewPruningOptions(0, 10, 15, 0), false},
+		{NewPruningOptions(-1, 10, 100, 0), false},
+		{NewPruningOptions(0, 10, 100, -1), false},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.valid, tc.opts.IsValid(), "%+v", tc.opts)
+	}
+}
+
+func TestPruningOptionsRecentVersions(t *testing.T) {
+	require.Equal(t, int64(0), PruneEverything.RecentVersions())
+	require.Equal(t, int64(1), PruneSyncable.RecentVersions())
+	require.Equal(t, int64(7), NewPruningOptions(7, 100, 10000, 0).RecentVersions())
+}
+
+func TestPruningOptionsPruneVersion(t *testing.T) {
+	// without an interval, versions are pruned on every commit
+	require.True(t, PruneEverything.PruneVersion(3))
+
+	opts := NewPruningOptions(0, 1, 0, 10)
+	require.False(t, opts.PruneVersion(9))
+	require.True(t, opts.PruneVersion(10))
+}
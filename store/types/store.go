@@ -155,6 +155,14 @@ type CommitMultiStore interface {
 	// Set an inter-block (persistent) cache that maintains a mapping from
 	// StoreKeys to CommitKVStores.
 	SetInterBlockCache(MultiStorePersistentCache)
+
+	// AddListeners adds WriteListeners for the KVStore belonging to the provided
+	// StoreKey. They are notified of every write flushed to the store.
+	AddListeners(key StoreKey, listeners []WriteListener)
+
+	// ListeningEnabled returns if listening is enabled for the KVStore belonging
+	// to the provided StoreKey.
+	ListeningEnabled(key StoreKey) bool
 }
 
 //---------subsp-------------------------------
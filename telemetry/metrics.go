@@ -0,0 +1,150 @@
+package telemetry
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/common/expfmt"
+)
+
+// Supported formats of the metrics returned by Metrics.Gather.
+const (
+	FormatDefault    = ""
+	FormatPrometheus = "prometheus"
+	FormatText       = "text"
+)
+
+// Config defines the configuration options for application telemetry.
+type Config struct {
+	// ServiceName is the identifier of the service the metrics belong to. When
+	// set, it is used as the prefix of every metric name.
+	ServiceName string `mapstructure:"service-name"`
+
+	// Enabled enables the application telemetry functionality. When enabled,
+	// metrics are kept in memory and exposed to Prometheus. Otherwise, every
+	// metric call is a no-op.
+	Enabled bool `mapstructure:"enabled"`
+
+	// EnableHostnameLabel enables the addition of a "host" label, holding the
+	// hostname of the node, to every metric.
+	EnableHostnameLabel bool `mapstructure:"enable-hostname-label"`
+
+	// PrometheusRetentionTime, when positive, defines how long a metric that is
+	// not updated anymore is kept (in seconds). Zero keeps metrics forever.
+	PrometheusRetentionTime int64 `mapstructure:"prometheus-retention-time"`
+
+	// GlobalLabels defines a global set of name/value label tuples applied to
+	// every metric, e.g. [["chain_id", "cosmoshub-1"]].
+	GlobalLabels [][]string `mapstructure:"global-labels"`
+}
+
+// Metrics defines a wrapper around the application telemetry functionality. It
+// aggregates the emitted metrics in memory and exposes them either as a
+// Prometheus collector or through Gather.
+type Metrics struct {
+	sink     *memSink
+	registry *prometheus.Registry
+}
+
+// GatherResponse is the response type of a metrics gathering request.
+type GatherResponse struct {
+	Metrics     []byte
+	ContentType string
+}
+
+// New creates a new Metrics instance from the given configuration. If the
+// configuration enables telemetry, the instance becomes the global metrics
+// sink used by the package-level functions, e.g. IncrCounter.
+func New(cfg Config) (*Metrics, error) {
+	var labels []Label
+	for i, gl := range cfg.GlobalLabels {
+		if len(gl) != 2 {
+			return nil, fmt.Errorf("global label %d must be a name/value tuple; got %v", i, gl)
+		}
+
+		labels = append(labels, NewLabel(gl[0], gl[1]))
+	}
+
+	if cfg.EnableHostnameLabel {
+		hostname, err := os.Hostname()
+		if err != nil {
+			return nil, err
+		}
+
+		labels = append(labels, NewLabel("host", hostname))
+	}
+
+	sink := newMemSink(cfg.ServiceName, labels, time.Duration(cfg.PrometheusRetentionTime)*time.Second)
+
+	m := &Metrics{sink: sink, registry: prometheus.NewRegistry()}
+	if err := m.registry.Register(sink); err != nil {
+		return nil, err
+	}
+
+	if cfg.Enabled {
+		setGlobalSink(sink)
+	}
+
+	return m, nil
+}
+
+// Describe implements the prometheus.Collector interface, allowing the metrics
+// to be registered with an external Prometheus registry, e.g. the default one
+// served by Tendermint's instrumentation endpoint.
+func (m *Metrics) Describe(ch chan<- *prometheus.Desc) {
+	m.sink.Describe(ch)
+}
+
+// Collect implements the prometheus.Collector interface.
+func (m *Metrics) Collect(ch chan<- prometheus.Metric) {
+	m.sink.Collect(ch)
+}
+
+// Gather collects all registered metrics and returns a GatherResponse where the
+// metrics are encoded depending on the type. Metrics are either encoded via
+// Prometheus or as JSON (the default).
+func (m *Metrics) Gather(format string) (GatherResponse, error) {
+	switch format {
+	case FormatPrometheus:
+		return m.gatherPrometheus()
+
+	case FormatText, FormatDefault:
+		return m.gatherText()
+
+	default:
+		return GatherResponse{}, fmt.Errorf("unsupported metrics format: %s", format)
+	}
+}
+
+func (m *Metrics) gatherPrometheus() (GatherResponse, error) {
+	metricsFamilies, err := m.registry.Gather()
+	if err != nil {
+		return GatherResponse{}, errors.Wrap(err, "failed to gather prometheus metrics")
+	}
+
+	buf := &bytes.Buffer{}
+	defer buf.Reset()
+
+	e := expfmt.NewEncoder(buf, expfmt.FmtText)
+	for _, mf := range metricsFamilies {
+		if err := e.Encode(mf); err != nil {
+			return GatherResponse{}, errors.Wrap(err, "failed to encode prometheus metrics")
+		}
+	}
+
+	return GatherResponse{ContentType: string(expfmt.FmtText), Metrics: buf.Bytes()}, nil
+}
+
+func (m *Metrics) gatherText() (GatherResponse, error) {
+	bz, err := json.Marshal(m.sink.Snapshot())
+	if err != nil {
+		return GatherResponse{}, errors.Wrap(err, "failed to encode in-memory metrics")
+	}
+
+	return GatherResponse{ContentType: "application/json", Metrics: bz}, nil
+}
@@ -0,0 +1,105 @@
+package telemetry
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetrics_Disabled(t *testing.T) {
+	m, err := New(Config{Enabled: false})
+	require.NoError(t, err)
+	require.False(t, IsTelemetryEnabled())
+
+	IncrCounter(1, "test", "counter")
+
+	res, err := m.Gather(FormatText)
+	require.NoError(t, err)
+
+	var snapshot MetricsSnapshot
+	require.NoError(t, json.Unmarshal(res.Metrics, &snapshot))
+	require.Empty(t, snapshot.Counters)
+}
+
+func TestMetrics_InMem(t *testing.T) {
+	m, err := New(Config{
+		ServiceName:  "test",
+		Enabled:      true,
+		GlobalLabels: [][]string{{"chain_id", "test-chain"}},
+	})
+	require.NoError(t, err)
+	require.True(t, IsTelemetryEnabled())
+	defer setGlobalSink(nil)
+
+	IncrCounter(1, "tx", "count")
+	IncrCounter(2, "tx", "count")
+	ModuleSetGauge("bank", 10, "supply")
+	AddSample(5, "tx", "gas_used")
+	AddSample(15, "tx", "gas_used")
+	MeasureSince(time.Now().Add(-time.Second), "block", "time")
+
+	res, err := m.Gather(FormatText)
+	require.NoError(t, err)
+	require.Equal(t, "application/json", res.ContentType)
+
+	var snapshot MetricsSnapshot
+	require.NoError(t, json.Unmarshal(res.Metrics, &snapshot))
+
+	require.Len(t, snapshot.Counters, 1)
+	require.Equal(t, "test_tx_count", snapshot.Counters[0].Name)
+	require.Equal(t, float64(3), snapshot.Counters[0].Value)
+	require.Equal(t, map[string]string{"chain_id": "test-chain"}, snapshot.Counters[0].Labels)
+
+	require.Len(t, snapshot.Gauges, 1)
+	require.Equal(t, float64(10), snapshot.Gauges[0].Value)
+	require.Equal(t, "bank", snapshot.Gauges[0].Labels[MetricLabelNameModule])
+
+	require.Len(t, snapshot.Samples, 2)
+	require.Equal(t, "test_block_time", snapshot.Samples[0].Name)
+	require.True(t, snapshot.Samples[0].Value >= 1000)
+	require.Equal(t, "test_tx_gas_used", snapshot.Samples[1].Name)
+	require.Equal(t, uint64(2), snapshot.Samples[1].Count)
+	require.Equal(t, float64(20), snapshot.Samples[1].Sum)
+	require.Equal(t, float64(5), snapshot.Samples[1].Min)
+	require.Equal(t, float64(15), snapshot.Samples[1].Max)
+}
+
+func TestMetrics_Prometheus(t *testing.T) {
+	m, err := New(Config{ServiceName: "test", Enabled: true})
+	require.NoError(t, err)
+	defer setGlobalSink(nil)
+
+	IncrCounterWithLabels([]string{"store", "iavl", "get"}, 1, []Label{NewLabel("store-key", "bank")})
+	SetGauge(7, "block", "height")
+	AddSample(100, "tx", "gas-used")
+
+	res, err := m.Gather(FormatPrometheus)
+	require.NoError(t, err)
+
+	text := string(res.Metrics)
+	require.True(t, strings.Contains(text, `test_store_iavl_get{store_key="bank"} 1`), text)
+	require.True(t, strings.Contains(text, "test_block_height 7"), text)
+	require.True(t, strings.Contains(text, "test_tx_gas_used_sum 100"), text)
+	require.True(t, strings.Contains(text, "test_tx_gas_used_count 1"), text)
+
+	_, err = m.Gather("unknown")
+	require.Error(t, err)
+}
+
+func TestMetrics_Retention(t *testing.T) {
+	sink := newMemSink("", nil, time.Millisecond)
+	sink.IncrCounter([]string{"counter"}, 1, nil)
+	require.Len(t, sink.Snapshot().Counters, 1)
+
+	time.Sleep(2 * time.Millisecond)
+	require.Empty(t, sink.Snapshot().Counters)
+}
+
+func TestNew_InvalidGlobalLabels(t *testing.T) {
+	_, err := New(Config{Enabled: true, GlobalLabels: [][]string{{"chain_id"}}})
+	require.Error(t, err)
+	require.False(t, IsTelemetryEnabled())
+}
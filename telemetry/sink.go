@@ -0,0 +1,254 @@
+package telemetry
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type metricKind int
+
+const (
+	kindCounter metricKind = iota
+	kindGauge
+	kindSample
+)
+
+// metricValue holds the aggregated value of a single metric, identified by its
+// name and label set.
+type metricValue struct {
+	kind    metricKind
+	name    string
+	labels  []Label
+	updated time.Time
+
+	// counters hold their cumulative sum and gauges their last value in value,
+	// while samples aggregate all observations.
+	value float64
+	count uint64
+	sum   float64
+	min   float64
+	max   float64
+}
+
+// MetricSnapshot is the JSON representation of a single metric returned by
+// Metrics.Gather in text format.
+type MetricSnapshot struct {
+	Name   string            `json:"name"`
+	Labels map[string]string `json:"labels,omitempty"`
+	Value  float64           `json:"value"`
+	Count  uint64            `json:"count,omitempty"`
+	Sum    float64           `json:"sum,omitempty"`
+	Min    float64           `json:"min,omitempty"`
+	Max    float64           `json:"max,omitempty"`
+}
+
+// MetricsSnapshot is the JSON representation of all metrics held by the
+// in-memory sink.
+type MetricsSnapshot struct {
+	Timestamp time.Time        `json:"timestamp"`
+	Counters  []MetricSnapshot `json:"counters"`
+	Gauges    []MetricSnapshot `json:"gauges"`
+	Samples   []MetricSnapshot `json:"samples"`
+}
+
+// memSink is an in-memory metrics sink. It aggregates every emitted metric and
+// implements the prometheus.Collector interface, converting the aggregated
+// values into constant Prometheus metrics upon collection.
+type memSink struct {
+	mtx sync.Mutex
+
+	prefix    string
+	labels    []Label
+	retention time.Duration
+	metrics   map[string]*metricValue
+}
+
+func newMemSink(prefix string, labels []Label, retention time.Duration) *memSink {
+	return &memSink{
+		prefix:    prefix,
+		labels:    labels,
+		retention: retention,
+		metrics:   make(map[string]*metricValue),
+	}
+}
+
+func (s *memSink) IncrCounter(keys []string, val float32, labels []Label) {
+	s.update(kindCounter, keys, labels, func(m *metricValue) {
+		m.value += float64(val)
+	})
+}
+
+func (s *memSink) SetGauge(keys []string, val float32, labels []Label) {
+	s.update(kindGauge, keys, labels, func(m *metricValue) {
+		m.value = float64(val)
+	})
+}
+
+func (s *memSink) AddSample(keys []string, val float32, labels []Label) {
+	s.update(kindSample, keys, labels, func(m *metricValue) {
+		v := float64(val)
+		if m.count == 0 || v < m.min {
+			m.min = v
+		}
+		if m.count == 0 || v > m.max {
+			m.max = v
+		}
+
+		m.count++
+		m.sum += v
+		m.value = v
+	})
+}
+
+func (s *memSink) update(kind metricKind, keys []string, labels []Label, fn func(*metricValue)) {
+	name := s.metricName(keys)
+
+	labels = append(append([]Label{}, s.labels...), labels...)
+	sort.Slice(labels, func(i, j int) bool { return labels[i].Name < labels[j].Name })
+
+	key := metricKey(kind, name, labels)
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	m, ok := s.metrics[key]
+	if !ok {
+		m = &metricValue{kind: kind, name: name, labels: labels}
+		s.metrics[key] = m
+	}
+
+	fn(m)
+	m.updated = time.Now()
+}
+
+// Describe implements the prometheus.Collector interface. No descriptors are
+// sent as metrics are created dynamically, making the sink an unchecked
+// collector.
+func (s *memSink) Describe(_ chan<- *prometheus.Desc) {}
+
+// Collect implements the prometheus.Collector interface.
+func (s *memSink) Collect(ch chan<- prometheus.Metric) {
+	for _, m := range s.values() {
+		names := make([]string, len(m.labels))
+		values := make([]string, len(m.labels))
+		for i, l := range m.labels {
+			names[i], values[i] = l.Name, l.Value
+		}
+
+		desc := prometheus.NewDesc(m.name, m.name, names, nil)
+
+		switch m.kind {
+		case kindCounter:
+			ch <- prometheus.MustNewConstMetric(desc, prometheus.CounterValue, m.value, values...)
+
+		case kindGauge:
+			ch <- prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, m.value, values...)
+
+		case kindSample:
+			ch <- prometheus.MustNewConstSummary(desc, m.count, m.sum, nil, values...)
+		}
+	}
+}
+
+// Snapshot returns the current value of every metric held by the sink.
+func (s *memSink) Snapshot() MetricsSnapshot {
+	snapshot := MetricsSnapshot{
+		Timestamp: time.Now().UTC(),
+		Counters:  []MetricSnapshot{},
+		Gauges:    []MetricSnapshot{},
+		Samples:   []MetricSnapshot{},
+	}
+
+	for _, m := range s.values() {
+		ms := MetricSnapshot{Name: m.name, Value: m.value}
+		if len(m.labels) > 0 {
+			ms.Labels = make(map[string]string, len(m.labels))
+			for _, l := range m.labels {
+				ms.Labels[l.Name] = l.Value
+			}
+		}
+
+		switch m.kind {
+		case kindCounter:
+			snapshot.Counters = append(snapshot.Counters, ms)
+
+		case kindGauge:
+			snapshot.Gauges = append(snapshot.Gauges, ms)
+
+		case kindSample:
+			ms.Count, ms.Sum, ms.Min, ms.Max = m.count, m.sum, m.min, m.max
+			snapshot.Samples = append(snapshot.Samples, ms)
+		}
+	}
+
+	return snapshot
+}
+
+// values returns a copy of all metrics sorted by their key. Metrics which have
+// not been updated within the retention period are removed.
+func (s *memSink) values() []metricValue {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	keys := make([]string, 0, len(s.metrics))
+	for k, m := range s.metrics {
+		if s.retention > 0 && time.Since(m.updated) > s.retention {
+			delete(s.metrics, k)
+			continue
+		}
+
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	values := make([]metricValue, len(keys))
+	for i, k := range keys {
+		values[i] = *s.metrics[k]
+	}
+
+	return values
+}
+
+// metricName joins the given keys, prefixed by the service name, into a valid
+// Prometheus metric name, e.g. "gaiad_store_iavl_get".
+func (s *memSink) metricName(keys []string) string {
+	if s.prefix != "" {
+		keys = append([]string{s.prefix}, keys...)
+	}
+
+	return sanitizeName(strings.Join(keys, "_"))
+}
+
+func metricKey(kind metricKind, name string, labels []Label) string {
+	var sb strings.Builder
+
+	sb.WriteString(name)
+	for _, l := range labels {
+		sb.WriteString(";")
+		sb.WriteString(l.Name)
+		sb.WriteString("=")
+		sb.WriteString(l.Value)
+	}
+
+	// a name may only be used with a single kind, but keep the kinds apart so a
+	// misuse does not corrupt the aggregated value
+	return string(rune('0'+kind)) + sb.String()
+}
+
+// sanitizeName replaces all characters that are invalid in a Prometheus metric
+// or label name with an underscore.
+func sanitizeName(name string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '_', r == ':':
+			return r
+		default:
+			return '_'
+		}
+	}, name)
+}
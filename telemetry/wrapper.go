@@ -0,0 +1,125 @@
+package telemetry
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// Common metric key constants
+const (
+	MetricKeyBeginBlocker = "begin_blocker"
+	MetricKeyEndBlocker   = "end_blocker"
+	MetricLabelNameModule = "module"
+)
+
+// Label is a name/value pair attached to a metric.
+type Label struct {
+	Name  string
+	Value string
+}
+
+// NewLabel creates a new Label. The name is sanitized to form a valid
+// Prometheus label name.
+func NewLabel(name, value string) Label {
+	return Label{Name: sanitizeName(name), Value: value}
+}
+
+// sink defines the interface through which metrics are emitted.
+type sink interface {
+	IncrCounter(keys []string, val float32, labels []Label)
+	SetGauge(keys []string, val float32, labels []Label)
+	AddSample(keys []string, val float32, labels []Label)
+}
+
+// sinkHolder wraps the global sink so it can be stored in an atomic.Value.
+type sinkHolder struct {
+	sink sink
+}
+
+var globalSink atomic.Value
+
+func setGlobalSink(s sink) {
+	globalSink.Store(sinkHolder{s})
+}
+
+func getGlobalSink() sink {
+	holder, ok := globalSink.Load().(sinkHolder)
+	if !ok {
+		return nil
+	}
+
+	return holder.sink
+}
+
+// IsTelemetryEnabled returns true if a global metrics sink has been set up
+// through New, i.e. if metrics are collected.
+func IsTelemetryEnabled() bool {
+	return getGlobalSink() != nil
+}
+
+// ModuleMeasureSince provides a short hand method for emitting a time measure
+// metric for a module with a given set of keys.
+func ModuleMeasureSince(module string, start time.Time, keys ...string) {
+	MeasureSinceWithLabels(keys, start, []Label{NewLabel(MetricLabelNameModule, module)})
+}
+
+// ModuleSetGauge provides a short hand method for emitting a gauge metric for a
+// module with a given set of keys.
+func ModuleSetGauge(module string, val float32, keys ...string) {
+	SetGaugeWithLabels(keys, val, []Label{NewLabel(MetricLabelNameModule, module)})
+}
+
+// IncrCounter provides a wrapper functionality for emitting a counter metric.
+func IncrCounter(val float32, keys ...string) {
+	IncrCounterWithLabels(keys, val, nil)
+}
+
+// IncrCounterWithLabels provides a wrapper functionality for emitting a counter
+// metric with labels.
+func IncrCounterWithLabels(keys []string, val float32, labels []Label) {
+	if s := getGlobalSink(); s != nil {
+		s.IncrCounter(keys, val, labels)
+	}
+}
+
+// SetGauge provides a wrapper functionality for emitting a gauge metric.
+func SetGauge(val float32, keys ...string) {
+	SetGaugeWithLabels(keys, val, nil)
+}
+
+// SetGaugeWithLabels provides a wrapper functionality for emitting a gauge
+// metric with labels.
+func SetGaugeWithLabels(keys []string, val float32, labels []Label) {
+	if s := getGlobalSink(); s != nil {
+		s.SetGauge(keys, val, labels)
+	}
+}
+
+// AddSample provides a wrapper functionality for emitting a sample metric, e.g.
+// the gas used by a transaction.
+func AddSample(val float32, keys ...string) {
+	AddSampleWithLabels(keys, val, nil)
+}
+
+// AddSampleWithLabels provides a wrapper functionality for emitting a sample
+// metric with labels.
+func AddSampleWithLabels(keys []string, val float32, labels []Label) {
+	if s := getGlobalSink(); s != nil {
+		s.AddSample(keys, val, labels)
+	}
+}
+
+// MeasureSince provides a wrapper functionality for emitting a time measure
+// metric, in milliseconds, with the given keys.
+func MeasureSince(start time.Time, keys ...string) {
+	MeasureSinceWithLabels(keys, start, nil)
+}
+
+// MeasureSinceWithLabels provides a wrapper functionality for emitting a time
+// measure metric, in milliseconds, with the given keys and labels.
+func MeasureSinceWithLabels(keys []string, start time.Time, labels []Label) {
+	if s := getGlobalSink(); s != nil {
+		elapsed := time.Since(start)
+		s.AddSample(keys, float32(elapsed)/float32(time.Millisecond), labels)
+	}
+}
@@ -82,6 +82,10 @@ var (
 	// ErrTxTooLarge defines an ABCI typed error where tx is too large.
 	ErrTxTooLarge = Register(RootCodespace, 21, "tx too large")
 
+	// ErrMsgDisabled defines an ABCI typed error where a message is not
+	// dispatched because its type is disabled by the circuit breaker.
+	ErrMsgDisabled = Register(RootCodespace, 22, "message disabled")
+
 	// ErrPanic is only set when we recover from a panic, so we know to
 	// redact potentially sensitive system info
 	ErrPanic = Register(UndefinedCodespace, 111222, "panic")
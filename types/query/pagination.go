@@ -0,0 +1,189 @@
+// Package query defines the types and helpers shared by module queriers to
+// paginate the results of store iterations.
+package query
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultLimit is the default number of items returned per page if a request
+// does not define a limit.
+const DefaultLimit = 100
+
+// PageRequest defines the pagination parameters of a query. A page is either
+// selected by the key of its first item, which is the NextKey returned with
+// the previous page, or by the number of items to skip. Key-based pagination
+// is more efficient as it does not iterate over the skipped items.
+type PageRequest struct {
+	// Key is the store key (without the iterated prefix) of the first item of
+	// the page. Only one of Key and Offset may be set.
+	Key []byte `json:"key,omitempty" yaml:"key,omitempty"`
+
+	// Offset is the number of items to skip before the first item of the page.
+	Offset uint64 `json:"offset,omitempty" yaml:"offset,omitempty"`
+
+	// Limit is the maximum number of items of the page. DefaultLimit is used
+	// when zero.
+	Limit uint64 `json:"limit,omitempty" yaml:"limit,omitempty"`
+
+	// CountTotal requests the total number of items to be returned along with
+	// an offset-based page. It is ignored for key-based pagination.
+	CountTotal bool `json:"count_total,omitempty" yaml:"count_total,omitempty"`
+}
+
+// NewPageRequest creates a new PageRequest instance.
+func NewPageRequest(key []byte, offset, limit uint64, countTotal bool) *PageRequest {
+	return &PageRequest{
+		Key:        key,
+		Offset:     offset,
+		Limit:      limit,
+		CountTotal: countTotal,
+	}
+}
+
+// ValidateBasic performs basic validation of the pagination parameters.
+func (pr PageRequest) ValidateBasic() error {
+	if len(pr.Key) != 0 && pr.Offset > 0 {
+		return errors.New("invalid page request; either key or offset may be set, not both")
+	}
+
+	return nil
+}
+
+// PageResponse defines the pagination information returned with a page.
+type PageResponse struct {
+	// NextKey is the key of the first item of the next page, to be set as the
+	// Key of the next PageRequest. It is empty if there are no more items.
+	NextKey []byte `json:"next_key" yaml:"next_key"`
+
+	// Total is the total number of items, returned for offset-based requests
+	// if CountTotal was set.
+	Total uint64 `json:"total,omitempty" yaml:"total,omitempty"`
+}
+
+// Paginate iterates over the given prefix store and calls onResult with the
+// key and value of each item of the requested page. Keys are relative to the
+// prefix store. A nil request returns the first page and counts all items.
+func Paginate(
+	prefixStore sdk.KVStore, req *PageRequest, onResult func(key, value []byte) error,
+) (*PageResponse, error) {
+
+	return FilteredPaginate(prefixStore, req, func(key, value []byte, accumulate bool) (bool, error) {
+		if accumulate {
+			if err := onResult(key, value); err != nil {
+				return false, err
+			}
+		}
+
+		return true, nil
+	})
+}
+
+// FilteredPaginate behaves like Paginate, but only counts the items for which
+// onResult returns true. The accumulate argument signals whether the item is
+// part of the requested page and must be collected by onResult; items outside
+// of the page are passed with accumulate set to false so they can be filtered
+// and counted.
+func FilteredPaginate(
+	prefixStore sdk.KVStore, req *PageRequest, onResult func(key, value []byte, accumulate bool) (bool, error),
+) (*PageResponse, error) {
+
+	if req == nil {
+		req = &PageRequest{CountTotal: true}
+	}
+
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	limit := req.Limit
+	if limit == 0 {
+		limit = DefaultLimit
+	}
+
+	if len(req.Key) != 0 {
+		return paginateFromKey(prefixStore, req.Key, limit, onResult)
+	}
+
+	iterator := prefixStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	end := req.Offset + limit
+
+	var (
+		count   uint64
+		nextKey []byte
+	)
+
+	for ; iterator.Valid(); iterator.Next() {
+		accumulate := count >= req.Offset && count < end
+
+		hit, err := onResult(iterator.Key(), iterator.Value(), accumulate)
+		if err != nil {
+			return nil, err
+		}
+
+		if !hit {
+			continue
+		}
+
+		count++
+
+		if count == end+1 {
+			nextKey = iterator.Key()
+			if !req.CountTotal {
+				break
+			}
+		}
+	}
+
+	res := &PageResponse{NextKey: nextKey}
+	if req.CountTotal {
+		res.Total = count
+	}
+
+	return res, nil
+}
+
+func paginateFromKey(
+	prefixStore sdk.KVStore, key []byte, limit uint64, onResult func(key, value []byte, accumulate bool) (bool, error),
+) (*PageResponse, error) {
+
+	iterator := prefixStore.Iterator(key, nil)
+	defer iterator.Close()
+
+	var (
+		count   uint64
+		nextKey []byte
+	)
+
+	for ; iterator.Valid(); iterator.Next() {
+		if count == limit {
+			// the next matching item starts the next page
+			hit, err := onResult(iterator.Key(), iterator.Value(), false)
+			if err != nil {
+				return nil, err
+			}
+
+			if hit {
+				nextKey = iterator.Key()
+				break
+			}
+
+			continue
+		}
+
+		hit, err := onResult(iterator.Key(), iterator.Value(), true)
+		if err != nil {
+			return nil, err
+		}
+
+		if hit {
+			count++
+		}
+	}
+
+	return &PageResponse{NextKey: nextKey}, nil
+}
@@ -0,0 +1,113 @@
+package query_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+const numItems = 235
+
+func setupStore() sdk.KVStore {
+	store := dbadapter.Store{DB: dbm.NewMemDB()}
+	for i := 0; i < numItems; i++ {
+		store.Set([]byte(fmt.Sprintf("items/%03d", i)), []byte{byte(i % 2)})
+	}
+	store.Set([]byte("other"), []byte{0})
+
+	return prefix.NewStore(store, []byte("items/"))
+}
+
+func collect(t *testing.T, store sdk.KVStore, req *query.PageRequest) ([]string, *query.PageResponse) {
+	var keys []string
+	res, err := query.Paginate(store, req, func(key, _ []byte) error {
+		keys = append(keys, string(key))
+		return nil
+	})
+	require.NoError(t, err)
+
+	return keys, res
+}
+
+func TestPaginate(t *testing.T) {
+	store := setupStore()
+
+	// nil requests return the first page and count all items
+	keys, res := collect(t, store, nil)
+	require.Len(t, keys, query.DefaultLimit)
+	require.Equal(t, "000", keys[0])
+	require.Equal(t, []byte("100"), res.NextKey)
+	require.Equal(t, uint64(numItems), res.Total)
+
+	// offset-based pagination
+	keys, res = collect(t, store, query.NewPageRequest(nil, 230, 10, false))
+	require.Equal(t, []string{"230", "231", "232", "233", "234"}, keys)
+	require.Empty(t, res.NextKey)
+	require.Zero(t, res.Total)
+
+	keys, res = collect(t, store, query.NewPageRequest(nil, 10, 5, true))
+	require.Equal(t, []string{"010", "011", "012", "013", "014"}, keys)
+	require.Equal(t, []byte("015"), res.NextKey)
+	require.Equal(t, uint64(numItems), res.Total)
+
+	// key-based pagination walks through all pages
+	var (
+		all []string
+		key []byte
+	)
+	for {
+		keys, res := collect(t, store, query.NewPageRequest(key, 0, 50, false))
+		all = append(all, keys...)
+		if len(res.NextKey) == 0 {
+			break
+		}
+
+		key = res.NextKey
+	}
+	require.Len(t, all, numItems)
+	require.Equal(t, "234", all[numItems-1])
+
+	_, err := query.Paginate(store, query.NewPageRequest([]byte("001"), 1, 0, false), func(_, _ []byte) error {
+		return nil
+	})
+	require.Error(t, err)
+}
+
+func TestFilteredPaginate(t *testing.T) {
+	store := setupStore()
+
+	// only keep odd items
+	filter := func(keys *[]string) func(key, value []byte, accumulate bool) (bool, error) {
+		return func(key, value []byte, accumulate bool) (bool, error) {
+			if value[0] != 1 {
+				return false, nil
+			}
+
+			if accumulate {
+				*keys = append(*keys, string(key))
+			}
+
+			return true, nil
+		}
+	}
+
+	var keys []string
+	res, err := query.FilteredPaginate(store, query.NewPageRequest(nil, 2, 3, true), filter(&keys))
+	require.NoError(t, err)
+	require.Equal(t, []string{"005", "007", "009"}, keys)
+	require.Equal(t, []byte("011"), res.NextKey)
+	require.Equal(t, uint64(117), res.Total)
+
+	keys = nil
+	res, err = query.FilteredPaginate(store, query.NewPageRequest(res.NextKey, 0, 2, false), filter(&keys))
+	require.NoError(t, err)
+	require.Equal(t, []string{"011", "013"}, keys)
+	require.Equal(t, []byte("015"), res.NextKey)
+}
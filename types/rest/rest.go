@@ -3,6 +3,7 @@
 package rest
 
 import (
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -17,6 +18,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 const (
@@ -386,3 +388,42 @@ func ParseQueryParamBool(r *http.Request, param string) bool {
 	}
 	return value
 }
+
+// ParsePageRequest parses the pagination query parameters of the request into a
+// PageRequest. Pages are selected either by the base64 encoded "page_key" or by
+// "offset", optionally derived from a 1-indexed "page", with "limit" items per
+// page. If "count_total" is set, the total number of items is returned.
+func ParsePageRequest(r *http.Request) (*query.PageRequest, error) {
+	key, err := base64.StdEncoding.DecodeString(r.FormValue("page_key"))
+	if err != nil {
+		return nil, errors.New("page_key must be base64 encoded")
+	}
+
+	var offset, limit, page uint64
+	for param, value := range map[string]*uint64{"offset": &offset, "limit": &limit, "page": &page} {
+		if str := r.FormValue(param); str != "" {
+			if *value, err = strconv.ParseUint(str, 10, 64); err != nil {
+				return nil, fmt.Errorf("invalid %s: %s", param, str)
+			}
+		}
+	}
+
+	if page > 0 {
+		if offset > 0 {
+			return nil, errors.New("page and offset cannot be used together")
+		}
+
+		if limit == 0 {
+			limit = query.DefaultLimit
+		}
+
+		offset = (page - 1) * limit
+	}
+
+	req := query.NewPageRequest(key, offset, limit, ParseQueryParamBool(r, "count_total"))
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
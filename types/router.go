@@ -12,6 +12,12 @@ type Router interface {
 	Route(ctx Context, path string) Handler
 }
 
+// CircuitBreaker decides whether a message may be dispatched to its handler,
+// which allows message types to be paused without halting the chain.
+type CircuitBreaker interface {
+	IsAllowed(ctx Context, msg Msg) bool
+}
+
 // QueryRouter provides queryables for each query path.
 type QueryRouter interface {
 	AddRoute(r string, h Querier) QueryRouter
@@ -142,6 +142,15 @@ type KVPair = types.KVPair
 // every trace operation.
 type TraceContext = types.TraceContext
 
+//----------------------------------------
+
+// WriteListener and StoreKVPair are used to stream the writes flushed to a
+// KVStore, e.g. by a baseapp.StreamingService.
+type (
+	WriteListener = types.WriteListener
+	StoreKVPair   = types.StoreKVPair
+)
+
 // --------------------------------------
 
 // nolint - reexport
@@ -29,6 +29,12 @@ const (
 var (
 	// functions aliases
 	NewAnteHandler                    = ante.NewAnteHandler
+	DefaultAnteDecorators             = ante.DefaultAnteDecorators
+	ReplaceDecorator                  = ante.ReplaceDecorator
+	RemoveDecorator                   = ante.RemoveDecorator
+	InsertDecoratorsBefore            = ante.InsertDecoratorsBefore
+	InsertDecoratorsAfter             = ante.InsertDecoratorsAfter
+	AppendDecorators                  = ante.AppendDecorators
 	GetSignerAcc                      = ante.GetSignerAcc
 	DefaultSigVerificationGasConsumer = ante.DefaultSigVerificationGasConsumer
 	DeductFees                        = ante.DeductFees
@@ -75,6 +81,7 @@ var (
 
 type (
 	SignatureVerificationGasConsumer = ante.SignatureVerificationGasConsumer
+	DecoratorOption                  = ante.DecoratorOption
 	AccountKeeper                    = keeper.AccountKeeper
 	BaseAccount                      = types.BaseAccount
 	NodeQuerier                      = types.NodeQuerier
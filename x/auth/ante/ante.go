@@ -8,9 +8,28 @@ import (
 
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
 // numbers, checks signatures & account numbers, and deducts fees from the first
-// signer.
-func NewAnteHandler(ak keeper.AccountKeeper, supplyKeeper types.SupplyKeeper, sigGasConsumer SignatureVerificationGasConsumer) sdk.AnteHandler {
-	return sdk.ChainAnteDecorators(
+// signer. The default decorator chain may be customized through the given
+// options, which are applied in order.
+func NewAnteHandler(
+	ak keeper.AccountKeeper, supplyKeeper types.SupplyKeeper, sigGasConsumer SignatureVerificationGasConsumer,
+	options ...DecoratorOption,
+) sdk.AnteHandler {
+
+	decorators := DefaultAnteDecorators(ak, supplyKeeper, sigGasConsumer)
+	for _, option := range options {
+		decorators = option(decorators)
+	}
+
+	return sdk.ChainAnteDecorators(decorators...)
+}
+
+// DefaultAnteDecorators returns the decorator chain of the default AnteHandler,
+// ordered from the outermost to the innermost AnteDecorator.
+func DefaultAnteDecorators(
+	ak keeper.AccountKeeper, supplyKeeper types.SupplyKeeper, sigGasConsumer SignatureVerificationGasConsumer,
+) []sdk.AnteDecorator {
+
+	return []sdk.AnteDecorator{
 		NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
 		NewMempoolFeeDecorator(),
 		NewValidateBasicDecorator(),
@@ -22,5 +41,5 @@ func NewAnteHandler(ak keeper.AccountKeeper, supplyKeeper types.SupplyKeeper, si
 		NewSigGasConsumeDecorator(ak, sigGasConsumer),
 		NewSigVerificationDecorator(ak),
 		NewIncrementSequenceDecorator(ak), // innermost AnteDecorator
-	)
+	}
 }
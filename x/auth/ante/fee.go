@@ -94,6 +94,12 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 		panic(fmt.Sprintf("%s module account has not been set", types.FeeCollectorName))
 	}
 
+	// fee allowances are handled by the x/feegrant AnteHandler; reject granted
+	// fees here rather than silently charging the fee payer
+	if granterTx, ok := tx.(interface{ FeeGranter() sdk.AccAddress }); ok && !granterTx.FeeGranter().Empty() {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "fee granter is not supported by this AnteHandler")
+	}
+
 	feePayer := feeTx.FeePayer()
 	feePayerAcc := dfd.ak.GetAccount(ctx, feePayer)
 
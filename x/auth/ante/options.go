@@ -0,0 +1,87 @@
+package ante
+
+import (
+	"fmt"
+	"reflect"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DecoratorOption modifies the decorator chain built by NewAnteHandler. It
+// allows applications to insert, replace or remove decorators, e.g. to exempt
+// certain transactions from fees, without copying the entire chain.
+//
+// The options provided here locate decorators in the chain by their concrete
+// type, e.g. ReplaceDecorator(DeductFeeDecorator{}, myFeeDecorator) replaces the
+// default fee deduction. They panic if no decorator of the given type is part of
+// the chain, as the AnteHandler is expected to be built when wiring the app.
+type DecoratorOption func(decorators []sdk.AnteDecorator) []sdk.AnteDecorator
+
+// ReplaceDecorator returns a DecoratorOption that replaces the decorator of the
+// same type as target with the given replacement.
+func ReplaceDecorator(target, replacement sdk.AnteDecorator) DecoratorOption {
+	return func(decorators []sdk.AnteDecorator) []sdk.AnteDecorator {
+		i := mustFindDecorator(decorators, target)
+
+		res := append([]sdk.AnteDecorator{}, decorators...)
+		res[i] = replacement
+		return res
+	}
+}
+
+// RemoveDecorator returns a DecoratorOption that removes the decorator of the
+// same type as target.
+func RemoveDecorator(target sdk.AnteDecorator) DecoratorOption {
+	return func(decorators []sdk.AnteDecorator) []sdk.AnteDecorator {
+		i := mustFindDecorator(decorators, target)
+
+		res := append([]sdk.AnteDecorator{}, decorators[:i]...)
+		return append(res, decorators[i+1:]...)
+	}
+}
+
+// InsertDecoratorsBefore returns a DecoratorOption that inserts the given
+// decorators right before, i.e. outside of, the decorator of the same type as
+// target.
+func InsertDecoratorsBefore(target sdk.AnteDecorator, inserted ...sdk.AnteDecorator) DecoratorOption {
+	return func(decorators []sdk.AnteDecorator) []sdk.AnteDecorator {
+		return insertDecorators(decorators, mustFindDecorator(decorators, target), inserted)
+	}
+}
+
+// InsertDecoratorsAfter returns a DecoratorOption that inserts the given
+// decorators right after, i.e. inside of, the decorator of the same type as
+// target.
+func InsertDecoratorsAfter(target sdk.AnteDecorator, inserted ...sdk.AnteDecorator) DecoratorOption {
+	return func(decorators []sdk.AnteDecorator) []sdk.AnteDecorator {
+		return insertDecorators(decorators, mustFindDecorator(decorators, target)+1, inserted)
+	}
+}
+
+// AppendDecorators returns a DecoratorOption that appends the given decorators
+// to the end of the chain, i.e. they become the innermost decorators.
+func AppendDecorators(appended ...sdk.AnteDecorator) DecoratorOption {
+	return func(decorators []sdk.AnteDecorator) []sdk.AnteDecorator {
+		return insertDecorators(decorators, len(decorators), appended)
+	}
+}
+
+func insertDecorators(decorators []sdk.AnteDecorator, i int, inserted []sdk.AnteDecorator) []sdk.AnteDecorator {
+	res := make([]sdk.AnteDecorator, 0, len(decorators)+len(inserted))
+	res = append(res, decorators[:i]...)
+	res = append(res, inserted...)
+	return append(res, decorators[i:]...)
+}
+
+// mustFindDecorator returns the index of the first decorator of the same
+// concrete type as target and panics if there is none.
+func mustFindDecorator(decorators []sdk.AnteDecorator, target sdk.AnteDecorator) int {
+	targetType := reflect.TypeOf(target)
+	for i, d := range decorators {
+		if reflect.TypeOf(d) == targetType {
+			return i
+		}
+	}
+
+	panic(fmt.Sprintf("no AnteDecorator of type %T in the decorator chain", target))
+}
@@ -0,0 +1,105 @@
+package ante_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+var errRejected = errors.New("rejected by decorator")
+
+type markerDecorator struct{ name string }
+
+func (md markerDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	return next(ctx, tx, simulate)
+}
+
+type otherDecorator struct{ name string }
+
+func (od otherDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	return next(ctx, tx, simulate)
+}
+
+type rejectDecorator struct{}
+
+func (rd rejectDecorator) AnteHandle(ctx sdk.Context, _ sdk.Tx, _ bool, _ sdk.AnteHandler) (sdk.Context, error) {
+	return ctx, errRejected
+}
+
+func TestDecoratorOptions(t *testing.T) {
+	a, b := markerDecorator{"a"}, otherDecorator{"b"}
+	x, y := rejectDecorator{}, markerDecorator{"y"}
+
+	testCases := []struct {
+		name     string
+		option   ante.DecoratorOption
+		expected []sdk.AnteDecorator
+	}{
+		{"replace", ante.ReplaceDecorator(otherDecorator{}, x), []sdk.AnteDecorator{a, x}},
+		{"remove", ante.RemoveDecorator(markerDecorator{}), []sdk.AnteDecorator{b}},
+		{"insert before", ante.InsertDecoratorsBefore(otherDecorator{}, x, y), []sdk.AnteDecorator{a, x, y, b}},
+		{"insert after", ante.InsertDecoratorsAfter(markerDecorator{}, x), []sdk.AnteDecorator{a, x, b}},
+		{"append", ante.AppendDecorators(x, y), []sdk.AnteDecorator{a, b, x, y}},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			decorators := []sdk.AnteDecorator{a, b}
+			require.Equal(t, tc.expected, tc.option(decorators))
+			// the original chain must not be modified
+			require.Equal(t, []sdk.AnteDecorator{a, b}, decorators)
+		})
+	}
+
+	require.Panics(t, func() { ante.ReplaceDecorator(rejectDecorator{}, y)([]sdk.AnteDecorator{a, b}) })
+	require.Panics(t, func() { ante.RemoveDecorator(rejectDecorator{})([]sdk.AnteDecorator{a, b}) })
+	require.Panics(t, func() { ante.InsertDecoratorsBefore(rejectDecorator{}, y)([]sdk.AnteDecorator{a, b}) })
+	require.Panics(t, func() { ante.InsertDecoratorsAfter(rejectDecorator{}, y)([]sdk.AnteDecorator{a, b}) })
+}
+
+func TestAnteHandlerWithOptions(t *testing.T) {
+	app, ctx := createTestApp(true)
+
+	priv1, _, addr1 := types.KeyTestPubAddr()
+	acc1 := app.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	app.AccountKeeper.SetAccount(ctx, acc1)
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, addr1, types.NewTestCoins()))
+
+	msgs := []sdk.Msg{types.NewTestMsg(addr1)}
+	privs, accNums, seqs := []crypto.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	tx := types.NewTestTx(ctx, msgs, privs, accNums, seqs, types.NewTestStdFee())
+
+	decorators := ante.DefaultAnteDecorators(app.AccountKeeper, app.SupplyKeeper, ante.DefaultSigVerificationGasConsumer)
+	require.IsType(t, ante.SetUpContextDecorator{}, decorators[0])
+	require.IsType(t, ante.IncrementSequenceDecorator{}, decorators[len(decorators)-1])
+
+	// the default chain accepts the tx
+	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.SupplyKeeper, ante.DefaultSigVerificationGasConsumer)
+	_, err := anteHandler(ctx, tx, false)
+	require.NoError(t, err)
+
+	// an inserted decorator takes part in the chain
+	anteHandler = ante.NewAnteHandler(
+		app.AccountKeeper, app.SupplyKeeper, ante.DefaultSigVerificationGasConsumer,
+		ante.InsertDecoratorsAfter(ante.SigVerificationDecorator{}, rejectDecorator{}),
+	)
+	_, err = anteHandler(ctx, tx, false)
+	require.Equal(t, errRejected, err)
+
+	// replacing the fee deduction leaves the balance untouched
+	anteHandler = ante.NewAnteHandler(
+		app.AccountKeeper, app.SupplyKeeper, ante.DefaultSigVerificationGasConsumer,
+		ante.ReplaceDecorator(ante.DeductFeeDecorator{}, markerDecorator{}),
+	)
+	balance := app.BankKeeper.GetAllBalances(ctx, addr1)
+	_, err = anteHandler(ctx, tx, false)
+	require.NoError(t, err)
+	require.Equal(t, balance, app.BankKeeper.GetAllBalances(ctx, addr1))
+}
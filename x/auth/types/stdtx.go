@@ -163,14 +163,22 @@ func (tx StdTx) FeePayer() sdk.AccAddress {
 	return sdk.AccAddress{}
 }
 
+// FeeGranter returns the address of the account that granted the fee payer an
+// allowance to cover the transaction's fees, if any. When set, fees are deducted
+// from the granter's balance instead of the fee payer's. Granted fees are only
+// supported by AnteHandlers that are aware of fee allowances.
+func (tx StdTx) FeeGranter() sdk.AccAddress { return tx.Fee.Granter }
+
 //__________________________________________________________
 
 // StdFee includes the amount of coins paid in fees and the maximum
 // gas to be used by the transaction. The ratio yields an effective "gasprice",
-// which must be above some miminum to be accepted into the mempool.
+// which must be above some miminum to be accepted into the mempool. An optional
+// granter may be set to pay the fees on behalf of the fee payer.
 type StdFee struct {
-	Amount sdk.Coins `json:"amount" yaml:"amount"`
-	Gas    uint64    `json:"gas" yaml:"gas"`
+	Amount  sdk.Coins      `json:"amount" yaml:"amount"`
+	Gas     uint64         `json:"gas" yaml:"gas"`
+	Granter sdk.AccAddress `json:"granter,omitempty" yaml:"granter,omitempty"`
 }
 
 // NewStdFee returns a new instance of StdFee
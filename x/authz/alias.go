@@ -0,0 +1,66 @@
+package authz
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/authz/internal/keeper"
+	"github.com/cosmos/cosmos-sdk/x/authz/internal/types"
+)
+
+// nolint
+
+const (
+	ModuleName                   = types.ModuleName
+	StoreKey                     = types.StoreKey
+	RouterKey                    = types.RouterKey
+	QuerierRoute                 = types.QuerierRoute
+	QueryAuthorizations          = types.QueryAuthorizations
+	TypeMsgGrantAuthorization    = types.TypeMsgGrantAuthorization
+	TypeMsgRevokeAuthorization   = types.TypeMsgRevokeAuthorization
+	TypeMsgExecAuthorized        = types.TypeMsgExecAuthorized
+	EventTypeGrantAuthorization  = types.EventTypeGrantAuthorization
+	EventTypeRevokeAuthorization = types.EventTypeRevokeAuthorization
+	EventTypeExecAuthorized      = types.EventTypeExecAuthorized
+	AttributeKeyGranter          = types.AttributeKeyGranter
+	AttributeKeyGrantee          = types.AttributeKeyGrantee
+	AttributeKeyMsgType          = types.AttributeKeyMsgType
+	AttributeValueCategory       = types.AttributeValueCategory
+)
+
+var (
+	NewKeeper  = keeper.NewKeeper
+	NewQuerier = keeper.NewQuerier
+
+	RegisterCodec                  = types.RegisterCodec
+	RegisterAuthorizationTypeCodec = types.RegisterAuthorizationTypeCodec
+	ModuleCdc                      = types.ModuleCdc
+	MsgTypeOf                      = types.MsgTypeOf
+	NewGenericAuthorization        = types.NewGenericAuthorization
+	NewSendAuthorization           = types.NewSendAuthorization
+	NewDelegateAuthorization       = types.NewDelegateAuthorization
+	NewAuthorizationGrant          = types.NewAuthorizationGrant
+	NewMsgGrantAuthorization       = types.NewMsgGrantAuthorization
+	NewMsgRevokeAuthorization      = types.NewMsgRevokeAuthorization
+	NewMsgExecAuthorized           = types.NewMsgExecAuthorized
+	NewQueryAuthorizationsParams   = types.NewQueryAuthorizationsParams
+	NewGenesisState                = types.NewGenesisState
+	DefaultGenesisState            = types.DefaultGenesisState
+	AuthorizationKey               = types.AuthorizationKey
+	GrantsPrefix                   = types.GrantsPrefix
+
+	ErrNoAuthorizationFound  = types.ErrNoAuthorizationFound
+	ErrInvalidExpirationTime = types.ErrInvalidExpirationTime
+	ErrInvalidAuthorization  = types.ErrInvalidAuthorization
+)
+
+type (
+	Keeper = keeper.Keeper
+
+	GenericAuthorization      = types.GenericAuthorization
+	SendAuthorization         = types.SendAuthorization
+	DelegateAuthorization     = types.DelegateAuthorization
+	AuthorizationGrant        = types.AuthorizationGrant
+	MsgGrantAuthorization     = types.MsgGrantAuthorization
+	MsgRevokeAuthorization    = types.MsgRevokeAuthorization
+	MsgExecAuthorized         = types.MsgExecAuthorized
+	QueryAuthorizationsParams = types.QueryAuthorizationsParams
+	GenesisState              = types.GenesisState
+)
@@ -0,0 +1,206 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	"github.com/cosmos/cosmos-sdk/x/authz/exported"
+	"github.com/cosmos/cosmos-sdk/x/authz/internal/types"
+)
+
+const (
+	flagExpiration = "expiration"
+
+	authorizationTypeSend     = "send"
+	authorizationTypeDelegate = "delegate"
+	authorizationTypeGeneric  = "generic"
+)
+
+// GetTxCmd returns the transaction commands for the authz module.
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	authzTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Authorization transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	authzTxCmd.AddCommand(flags.PostCommands(
+		GetCmdGrantAuthorization(cdc),
+		GetCmdRevokeAuthorization(cdc),
+		GetCmdExecAuthorized(cdc),
+	)...)
+
+	return authzTxCmd
+}
+
+// GetCmdGrantAuthorization returns the command to grant an authorization.
+func GetCmdGrantAuthorization(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant [grantee] [send|delegate|generic] [spend-limit|msg-type]",
+		Short: "Grant an account the permission to execute messages on behalf of the signer",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Grant an account the permission to execute messages on behalf of the signer.
+A send or delegate authorization allows bank sends or staking delegations up to
+the given spend limit, a generic authorization allows all messages of the given
+type, identified by "<route>/<type>". The authorization may expire at a given time.
+
+Example:
+$ %s tx %s grant cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p send 1000stake --from mykey
+$ %s tx %s grant cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p generic distribution/withdraw_delegator_reward --from mykey
+`,
+				version.ClientName, types.ModuleName, version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			authorization, err := newAuthorization(args[1], args[2])
+			if err != nil {
+				return err
+			}
+
+			var expiration time.Time
+			if expirationStr := viper.GetString(flagExpiration); expirationStr != "" {
+				expiration, err = time.Parse(time.RFC3339, expirationStr)
+				if err != nil {
+					return fmt.Errorf("invalid expiration time: %w", err)
+				}
+			}
+
+			msg := types.NewMsgGrantAuthorization(cliCtx.GetFromAddress(), grantee, authorization, expiration)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagExpiration, "", "The RFC3339 time at which the authorization expires")
+
+	return cmd
+}
+
+// GetCmdRevokeAuthorization returns the command to revoke an authorization.
+func GetCmdRevokeAuthorization(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "revoke [grantee] [msg-type]",
+		Short: "Revoke the authorization the signer granted to an account for a message type",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Revoke the authorization the signer granted to an account for a message
+type, identified by "<route>/<type>".
+
+Example:
+$ %s tx %s revoke cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p bank/send --from mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeAuthorization(cliCtx.GetFromAddress(), grantee, args[1])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdExecAuthorized returns the command to execute the messages of a
+// generated transaction on behalf of their signers.
+func GetCmdExecAuthorized(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "exec [tx-json-file]",
+		Short: "Execute the messages of a transaction on behalf of the granters",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Execute the messages of a transaction, usually created with --generate-only
+by the granter, on behalf of their signers. The signer of this transaction must
+have been granted an authorization for every message.
+
+Example:
+$ %s tx bank send <granter> <recipient> 100stake --generate-only > tx.json
+$ %s tx %s exec tx.json --from mykey
+`,
+				version.ClientName, version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			stdTx, err := authclient.ReadStdTxFromFile(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgExecAuthorized(cliCtx.GetFromAddress(), stdTx.GetMsgs())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// newAuthorization returns the authorization of the given type for the given
+// spend limit or message type.
+func newAuthorization(authorizationType, arg string) (exported.Authorization, error) {
+	switch authorizationType {
+	case authorizationTypeSend, authorizationTypeDelegate:
+		spendLimit, err := sdk.ParseCoins(arg)
+		if err != nil {
+			return nil, err
+		}
+
+		if authorizationType == authorizationTypeSend {
+			return types.NewSendAuthorization(spendLimit), nil
+		}
+		return types.NewDelegateAuthorization(spendLimit), nil
+
+	case authorizationTypeGeneric:
+		return types.NewGenericAuthorization(arg), nil
+
+	default:
+		return nil, fmt.Errorf(
+			"invalid authorization type %q; must be one of %s, %s or %s",
+			authorizationType, authorizationTypeSend, authorizationTypeDelegate, authorizationTypeGeneric,
+		)
+	}
+}
@@ -0,0 +1,33 @@
+/*
+Package authz implements a Cosmos SDK module that allows an account, the granter,
+to grant another account, the grantee, the permission to execute specific types
+of messages on the granter's behalf, e.g. so custodians and bots can be given
+scoped permissions without sharing keys.
+
+All concrete authorization types must implement the Authorization interface
+contract. The module provides a GenericAuthorization, which allows all messages
+of a given type, and a SendAuthorization and DelegateAuthorization, which allow
+bank sends and staking delegations up to a spend limit respectively.
+Authorizations are granted with MsgGrantAuthorization, optionally until an
+expiration time, and removed either with MsgRevokeAuthorization or once they are
+used up.
+
+The grantee executes messages with MsgExecAuthorized, whose messages are routed
+to their respective handlers as if they had been signed by the granter once
+accepted by the corresponding authorization.
+
+A full setup of the authz module may look something as follows:
+
+	ModuleBasics = module.NewBasicManager(
+	  // ...,
+	  authz.AppModuleBasic{},
+	)
+
+	authzKeeper := authz.NewKeeper(app.cdc, keys[authz.StoreKey], app.Router())
+
+	app.mm = module.NewManager(
+	  // ...
+	  authz.NewAppModule(authzKeeper),
+	)
+*/
+package authz
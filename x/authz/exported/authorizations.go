@@ -0,0 +1,27 @@
+package exported
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Authorization represents the permission a granter gives a grantee to execute
+// a given type of message on the granter's behalf.
+type Authorization interface {
+	// MsgType returns the route and type of the messages, in the form
+	// "<route>/<type>", the authorization applies to.
+	MsgType() string
+
+	// Accept determines whether the grantee may execute the given message on
+	// behalf of the granter. If it returns an error, the message is rejected,
+	// otherwise it is accepted and the authorization, which is expected to
+	// update its internal state, is saved again.
+	//
+	// If remove is true (regardless of the error), the authorization is deleted
+	// from the store, e.g. once its spend limit has been used up.
+	Accept(msg sdk.Msg, block abci.Header) (remove bool, err error)
+
+	// ValidateBasic should evaluate this Authorization for internal consistency.
+	ValidateBasic() error
+}
@@ -0,0 +1,47 @@
+package authz_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+func TestImportExportGenesis(t *testing.T) {
+	app := simapp.Setup(false)
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Height: 1, Time: now})
+
+	granter := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	grantee := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	coins := sdk.NewCoins(sdk.NewInt64Coin("atom", 1000))
+
+	grants := []authz.AuthorizationGrant{
+		authz.NewAuthorizationGrant(granter, grantee, authz.NewSendAuthorization(coins), now.Add(time.Hour)),
+		authz.NewAuthorizationGrant(grantee, granter, authz.NewGenericAuthorization("gov/vote"), time.Time{}),
+	}
+	expired := authz.NewAuthorizationGrant(granter, grantee, authz.NewDelegateAuthorization(coins), now)
+
+	genesis := authz.NewGenesisState(append(grants, expired))
+	bz := authz.ModuleCdc.MustMarshalJSON(genesis)
+	require.NoError(t, authz.AppModuleBasic{}.ValidateGenesis(bz))
+
+	authz.InitGenesis(ctx, app.AuthzKeeper, genesis)
+
+	// the expired grant is not exported
+	exported := authz.ExportGenesis(ctx, app.AuthzKeeper)
+	require.ElementsMatch(t, grants, exported.Authorizations)
+
+	// an invalid grant is rejected
+	invalid := authz.NewGenesisState([]authz.AuthorizationGrant{
+		authz.NewAuthorizationGrant(granter, granter, authz.NewSendAuthorization(coins), time.Time{}),
+	})
+	require.Error(t, invalid.Validate())
+	require.Panics(t, func() { authz.InitGenesis(ctx, app.AuthzKeeper, invalid) })
+}
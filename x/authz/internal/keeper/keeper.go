@@ -0,0 +1,228 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz/exported"
+	"github.com/cosmos/cosmos-sdk/x/authz/internal/types"
+)
+
+// Keeper manages the authorizations granted between accounts and dispatches
+// the messages executed on behalf of a granter. It must have a codec with all
+// available authorizations registered.
+type Keeper struct {
+	cdc      *codec.Codec
+	storeKey sdk.StoreKey
+	router   sdk.Router
+}
+
+// NewKeeper creates an authz Keeper. The router is used to dispatch the
+// messages executed through MsgExecAuthorized.
+func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, router sdk.Router) Keeper {
+	return Keeper{
+		cdc:      cdc,
+		storeKey: storeKey,
+		router:   router,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// Grant stores the authorization grant, overwriting any existing authorization
+// for the same message type from the granter to the grantee.
+func (k Keeper) Grant(ctx sdk.Context, grant types.AuthorizationGrant) {
+	k.setGrant(ctx, grant)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeGrantAuthorization,
+			sdk.NewAttribute(types.AttributeKeyGranter, grant.Granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grant.Grantee.String()),
+			sdk.NewAttribute(types.AttributeKeyMsgType, grant.Authorization.MsgType()),
+		),
+	)
+}
+
+func (k Keeper) setGrant(ctx sdk.Context, grant types.AuthorizationGrant) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.AuthorizationKey(grant.Granter, grant.Grantee, grant.Authorization.MsgType())
+	store.Set(key, k.cdc.MustMarshalBinaryBare(grant))
+}
+
+// Revoke removes the authorization for the given message type from the granter
+// to the grantee. An error is returned if no such authorization exists.
+func (k Keeper) Revoke(ctx sdk.Context, granter, grantee sdk.AccAddress, msgType string) error {
+	store := ctx.KVStore(k.storeKey)
+	key := types.AuthorizationKey(granter, grantee, msgType)
+
+	if !store.Has(key) {
+		return sdkerrors.Wrapf(types.ErrNoAuthorizationFound, "granter %s, grantee %s, message type %s", granter, grantee, msgType)
+	}
+
+	store.Delete(key)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRevokeAuthorization,
+			sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+			sdk.NewAttribute(types.AttributeKeyMsgType, msgType),
+		),
+	)
+
+	return nil
+}
+
+// GetAuthorizationGrant returns the authorization grant for the given message
+// type from the granter to the grantee, and a boolean indicating whether it
+// was found.
+func (k Keeper) GetAuthorizationGrant(
+	ctx sdk.Context, granter, grantee sdk.AccAddress, msgType string,
+) (types.AuthorizationGrant, bool) {
+
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.AuthorizationKey(granter, grantee, msgType))
+	if len(bz) == 0 {
+		return types.AuthorizationGrant{}, false
+	}
+
+	var grant types.AuthorizationGrant
+	k.cdc.MustUnmarshalBinaryBare(bz, &grant)
+
+	return grant, true
+}
+
+// GetAuthorization returns the authorization for the given message type from
+// the granter to the grantee. It returns nil if there is none or if it has
+// expired.
+func (k Keeper) GetAuthorization(
+	ctx sdk.Context, granter, grantee sdk.AccAddress, msgType string,
+) exported.Authorization {
+
+	grant, found := k.GetAuthorizationGrant(ctx, granter, grantee, msgType)
+	if !found || grant.IsExpired(ctx.BlockTime()) {
+		return nil
+	}
+
+	return grant.Authorization
+}
+
+// GetGrants returns all the authorization grants from the granter to the
+// grantee.
+func (k Keeper) GetGrants(ctx sdk.Context, granter, grantee sdk.AccAddress) []types.AuthorizationGrant {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GrantsPrefix(granter, grantee))
+	defer iterator.Close()
+
+	grants := []types.AuthorizationGrant{}
+	for ; iterator.Valid(); iterator.Next() {
+		var grant types.AuthorizationGrant
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &grant)
+
+		grants = append(grants, grant)
+	}
+
+	return grants
+}
+
+// IterateGrants iterates over all the authorization grants in the store.
+// Iteration stops when the callback returns true.
+func (k Keeper) IterateGrants(ctx sdk.Context, cb func(types.AuthorizationGrant) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.AuthorizationKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var grant types.AuthorizationGrant
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &grant)
+
+		if cb(grant) {
+			break
+		}
+	}
+}
+
+// DispatchActions executes the given messages on behalf of their signers. Each
+// message whose signer is not the grantee must be accepted by an unexpired
+// authorization from its signer to the grantee. Authorizations are updated, or
+// removed once used up, before the message is routed to its handler.
+func (k Keeper) DispatchActions(ctx sdk.Context, grantee sdk.AccAddress, msgs []sdk.Msg) (*sdk.Result, error) {
+	var data []byte
+	events := sdk.EmptyEvents()
+
+	for i, msg := range msgs {
+		signers := msg.GetSigners()
+		if len(signers) != 1 {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "message must have exactly one signer; message index: %d", i)
+		}
+
+		granter := signers[0]
+		if !granter.Equals(grantee) {
+			if err := k.useAuthorization(ctx, granter, grantee, msg); err != nil {
+				return nil, sdkerrors.Wrapf(err, "message index: %d", i)
+			}
+		}
+
+		handler := k.router.Route(ctx, msg.Route())
+		if handler == nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s; message index: %d", msg.Route(), i)
+		}
+
+		msgResult, err := handler(ctx, msg)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "failed to execute message; message index: %d", i)
+		}
+
+		events = events.AppendEvents(sdk.Events{
+			sdk.NewEvent(sdk.EventTypeMessage, sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type())),
+		})
+		events = events.AppendEvents(msgResult.Events)
+		data = append(data, msgResult.Data...)
+	}
+
+	return &sdk.Result{Data: data, Events: events}, nil
+}
+
+func (k Keeper) useAuthorization(ctx sdk.Context, granter, grantee sdk.AccAddress, msg sdk.Msg) error {
+	msgType := types.MsgTypeOf(msg)
+
+	grant, found := k.GetAuthorizationGrant(ctx, granter, grantee, msgType)
+	if !found || grant.IsExpired(ctx.BlockTime()) {
+		return sdkerrors.Wrapf(
+			sdkerrors.ErrUnauthorized, "%s is not authorized to execute %s on behalf of %s", grantee, msgType, granter,
+		)
+	}
+
+	remove, err := grant.Authorization.Accept(msg, ctx.BlockHeader())
+	if remove {
+		// ignore the error, the existence of the grant was checked above
+		_ = k.Revoke(ctx, granter, grantee, msgType)
+	}
+	if err != nil {
+		return err
+	}
+
+	if !remove {
+		k.setGrant(ctx, grant)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeExecAuthorized,
+			sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+			sdk.NewAttribute(types.AttributeKeyMsgType, msgType),
+		),
+	)
+
+	return nil
+}
@@ -0,0 +1,163 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz/internal/keeper"
+	"github.com/cosmos/cosmos-sdk/x/authz/internal/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app    *simapp.SimApp
+	cdc    *codec.Codec
+	ctx    sdk.Context
+	keeper keeper.Keeper
+
+	addrs []sdk.AccAddress
+	now   time.Time
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.app = simapp.Setup(false)
+	suite.now = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	suite.cdc = suite.app.Codec()
+	suite.ctx = suite.app.BaseApp.NewContext(false, abci.Header{Height: 1, Time: suite.now})
+	suite.keeper = suite.app.AuthzKeeper
+
+	suite.addrs = simapp.AddTestAddrs(suite.app, suite.ctx, 3, sdk.NewInt(10000))
+}
+
+func (suite *KeeperTestSuite) TestKeeperCrud() {
+	ctx := suite.ctx
+	k := suite.keeper
+	granter, grantee := suite.addrs[0], suite.addrs[1]
+
+	send := types.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("atom", 100)))
+	generic := types.NewGenericAuthorization("gov/vote")
+
+	sendGrant := types.NewAuthorizationGrant(granter, grantee, send, suite.now.Add(time.Hour))
+	genericGrant := types.NewAuthorizationGrant(granter, grantee, generic, time.Time{})
+
+	k.Grant(ctx, sendGrant)
+	k.Grant(ctx, genericGrant)
+	k.Grant(ctx, types.NewAuthorizationGrant(grantee, granter, generic, time.Time{}))
+
+	suite.Require().Equal(send, k.GetAuthorization(ctx, granter, grantee, send.MsgType()))
+	suite.Require().Equal(generic, k.GetAuthorization(ctx, granter, grantee, generic.MsgType()))
+	suite.Require().Nil(k.GetAuthorization(ctx, grantee, granter, send.MsgType()))
+	suite.Require().ElementsMatch([]types.AuthorizationGrant{sendGrant, genericGrant}, k.GetGrants(ctx, granter, grantee))
+
+	// an expired authorization is not returned
+	expiredCtx := ctx.WithBlockTime(suite.now.Add(time.Hour))
+	suite.Require().Nil(k.GetAuthorization(expiredCtx, granter, grantee, send.MsgType()))
+	suite.Require().Equal(generic, k.GetAuthorization(expiredCtx, granter, grantee, generic.MsgType()))
+
+	// revoke one, revoking it again fails
+	suite.Require().NoError(k.Revoke(ctx, granter, grantee, send.MsgType()))
+	suite.Require().Error(k.Revoke(ctx, granter, grantee, send.MsgType()))
+	suite.Require().Nil(k.GetAuthorization(ctx, granter, grantee, send.MsgType()))
+	suite.Require().Equal([]types.AuthorizationGrant{genericGrant}, k.GetGrants(ctx, granter, grantee))
+
+	var all []types.AuthorizationGrant
+	k.IterateGrants(ctx, func(grant types.AuthorizationGrant) bool {
+		all = append(all, grant)
+		return false
+	})
+	suite.Require().Len(all, 2)
+}
+
+func (suite *KeeperTestSuite) TestDispatchActions() {
+	granter, grantee, recipient := suite.addrs[0], suite.addrs[1], suite.addrs[2]
+	atom := func(amt int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amt)) }
+	balance := func(ctx sdk.Context, addr sdk.AccAddress) sdk.Int {
+		return suite.app.BankKeeper.GetBalance(ctx, addr, sdk.DefaultBondDenom).Amount
+	}
+	send := func(amt int64) []sdk.Msg {
+		return []sdk.Msg{bank.NewMsgSend(granter, recipient, atom(amt))}
+	}
+
+	cases := []struct {
+		name       string
+		expiration time.Time
+		msgs       []sdk.Msg
+		valid      bool
+		remaining  sdk.Coins
+	}{
+		{
+			name:      "below limit",
+			msgs:      send(40),
+			valid:     true,
+			remaining: atom(60),
+		},
+		{
+			name:  "entire limit",
+			msgs:  send(100),
+			valid: true,
+		},
+		{
+			name: "above limit",
+			msgs: send(101),
+		},
+		{
+			name:       "expired",
+			expiration: suite.now,
+			msgs:       send(40),
+		},
+		{
+			name: "unauthorized signer",
+			msgs: []sdk.Msg{bank.NewMsgSend(recipient, granter, atom(40))},
+		},
+		{
+			name:      "own message",
+			msgs:      []sdk.Msg{bank.NewMsgSend(grantee, recipient, atom(40))},
+			valid:     true,
+			remaining: atom(100),
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			k := suite.keeper
+			msgType := types.MsgTypeOf(bank.MsgSend{})
+
+			k.Grant(ctx, types.NewAuthorizationGrant(granter, grantee, types.NewSendAuthorization(atom(100)), tc.expiration))
+
+			res, err := k.DispatchActions(ctx, grantee, tc.msgs)
+			if !tc.valid {
+				suite.Require().Error(err)
+				suite.Require().Equal(sdk.NewInt(10000), balance(ctx, recipient))
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().NotEmpty(res.Events)
+
+			sent := tc.msgs[0].(bank.MsgSend).Amount.AmountOf(sdk.DefaultBondDenom)
+			suite.Require().Equal(sdk.NewInt(10000).Add(sent), balance(ctx, recipient))
+
+			authorization := k.GetAuthorization(ctx, granter, grantee, msgType)
+			if tc.remaining.Empty() {
+				suite.Require().Nil(authorization)
+				return
+			}
+			suite.Require().Equal(types.NewSendAuthorization(tc.remaining), authorization)
+		})
+	}
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
@@ -0,0 +1,71 @@
+package keeper_test
+
+import (
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz/internal/keeper"
+	"github.com/cosmos/cosmos-sdk/x/authz/internal/types"
+)
+
+func (suite *KeeperTestSuite) TestQuery() {
+	ctx := suite.ctx
+	k := suite.keeper
+	granter, grantee := suite.addrs[0], suite.addrs[1]
+
+	grant1 := types.NewAuthorizationGrant(
+		granter, grantee, types.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("atom", 555))), time.Time{},
+	)
+	grant2 := types.NewAuthorizationGrant(
+		granter, grantee, types.NewGenericAuthorization("gov/vote"), suite.now.Add(time.Hour),
+	)
+
+	k.Grant(ctx, grant1)
+	k.Grant(ctx, grant2)
+
+	cases := map[string]struct {
+		path  []string
+		query types.QueryAuthorizationsParams
+		valid bool
+		res   []types.AuthorizationGrant
+	}{
+		"bad path": {
+			path:  []string{"foo", "bar"},
+			valid: false,
+		},
+		"no data": {
+			path:  []string{types.QueryAuthorizations},
+			query: types.NewQueryAuthorizationsParams(grantee, granter),
+			valid: true,
+			res:   []types.AuthorizationGrant{},
+		},
+		"two grants": {
+			path:  []string{types.QueryAuthorizations},
+			query: types.NewQueryAuthorizationsParams(granter, grantee),
+			valid: true,
+			res:   []types.AuthorizationGrant{grant1, grant2},
+		},
+	}
+
+	querier := keeper.NewQuerier(k)
+	for name, tc := range cases {
+		tc := tc
+
+		suite.Run(name, func() {
+			req := abci.RequestQuery{Data: suite.cdc.MustMarshalJSON(tc.query)}
+
+			bz, err := querier(ctx, tc.path, req)
+			if !tc.valid {
+				suite.Error(err)
+				return
+			}
+			suite.NoError(err)
+
+			var grants []types.AuthorizationGrant
+			suite.NoError(suite.cdc.UnmarshalJSON(bz, &grants))
+			suite.ElementsMatch(tc.res, grants)
+		})
+	}
+}
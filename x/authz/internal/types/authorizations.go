@@ -0,0 +1,131 @@
+package types
+
+import (
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz/exported"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+var (
+	_ exported.Authorization = (*GenericAuthorization)(nil)
+	_ exported.Authorization = (*SendAuthorization)(nil)
+	_ exported.Authorization = (*DelegateAuthorization)(nil)
+)
+
+// MsgTypeOf returns the route and type of a message in the form "<route>/<type>",
+// as used to identify the messages an Authorization applies to.
+func MsgTypeOf(msg sdk.Msg) string {
+	return fmt.Sprintf("%s/%s", msg.Route(), msg.Type())
+}
+
+// GenericAuthorization grants the permission to execute any message of the
+// given type without restrictions.
+type GenericAuthorization struct {
+	Msg string `json:"msg" yaml:"msg"`
+}
+
+func NewGenericAuthorization(msgType string) *GenericAuthorization {
+	return &GenericAuthorization{Msg: msgType}
+}
+
+// MsgType implements Authorization.
+func (a GenericAuthorization) MsgType() string { return a.Msg }
+
+// Accept implements Authorization. Every message of the authorized type is
+// accepted.
+func (a *GenericAuthorization) Accept(_ sdk.Msg, _ abci.Header) (bool, error) {
+	return false, nil
+}
+
+// ValidateBasic implements Authorization.
+func (a GenericAuthorization) ValidateBasic() error {
+	if a.Msg == "" {
+		return sdkerrors.Wrap(ErrInvalidAuthorization, "missing message type")
+	}
+
+	return nil
+}
+
+// SendAuthorization grants the permission to send up to SpendLimit coins from
+// the granter's account with bank MsgSend.
+type SendAuthorization struct {
+	SpendLimit sdk.Coins `json:"spend_limit" yaml:"spend_limit"`
+}
+
+func NewSendAuthorization(spendLimit sdk.Coins) *SendAuthorization {
+	return &SendAuthorization{SpendLimit: spendLimit}
+}
+
+// MsgType implements Authorization.
+func (a SendAuthorization) MsgType() string { return MsgTypeOf(bank.MsgSend{}) }
+
+// Accept implements Authorization. The amount sent is deducted from the spend
+// limit, and the authorization is removed once the limit has been used up.
+func (a *SendAuthorization) Accept(msg sdk.Msg, _ abci.Header) (bool, error) {
+	msgSend, ok := msg.(bank.MsgSend)
+	if !ok {
+		return false, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "expected %T, got %T", bank.MsgSend{}, msg)
+	}
+
+	limitLeft, isNeg := a.SpendLimit.SafeSub(msgSend.Amount)
+	if isNeg {
+		return false, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "requested amount is more than spend limit %s", a.SpendLimit)
+	}
+
+	a.SpendLimit = limitLeft
+	return limitLeft.IsZero(), nil
+}
+
+// ValidateBasic implements Authorization.
+func (a SendAuthorization) ValidateBasic() error {
+	if a.SpendLimit.Empty() || !a.SpendLimit.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "spend limit is invalid: %s", a.SpendLimit)
+	}
+
+	return nil
+}
+
+// DelegateAuthorization grants the permission to delegate up to SpendLimit
+// tokens from the granter's account with staking MsgDelegate.
+type DelegateAuthorization struct {
+	SpendLimit sdk.Coins `json:"spend_limit" yaml:"spend_limit"`
+}
+
+func NewDelegateAuthorization(spendLimit sdk.Coins) *DelegateAuthorization {
+	return &DelegateAuthorization{SpendLimit: spendLimit}
+}
+
+// MsgType implements Authorization.
+func (a DelegateAuthorization) MsgType() string { return MsgTypeOf(staking.MsgDelegate{}) }
+
+// Accept implements Authorization. The amount delegated is deducted from the
+// spend limit, and the authorization is removed once the limit has been used up.
+func (a *DelegateAuthorization) Accept(msg sdk.Msg, _ abci.Header) (bool, error) {
+	msgDelegate, ok := msg.(staking.MsgDelegate)
+	if !ok {
+		return false, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "expected %T, got %T", staking.MsgDelegate{}, msg)
+	}
+
+	limitLeft, isNeg := a.SpendLimit.SafeSub(sdk.NewCoins(msgDelegate.Amount))
+	if isNeg {
+		return false, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "requested amount is more than spend limit %s", a.SpendLimit)
+	}
+
+	a.SpendLimit = limitLeft
+	return limitLeft.IsZero(), nil
+}
+
+// ValidateBasic implements Authorization.
+func (a DelegateAuthorization) ValidateBasic() error {
+	if a.SpendLimit.Empty() || !a.SpendLimit.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "spend limit is invalid: %s", a.SpendLimit)
+	}
+
+	return nil
+}
@@ -0,0 +1,120 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz/exported"
+	"github.com/cosmos/cosmos-sdk/x/authz/internal/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+func TestAuthorizationAccept(t *testing.T) {
+	from := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	to := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	val := sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	atom := func(amt int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("atom", amt)) }
+	send := func(amt int64) sdk.Msg { return bank.NewMsgSend(from, to, atom(amt)) }
+	delegate := func(amt int64) sdk.Msg { return staking.NewMsgDelegate(from, val, sdk.NewInt64Coin("atom", amt)) }
+
+	cases := []struct {
+		name          string
+		authorization exported.Authorization
+		msg           sdk.Msg
+		accept        bool
+		remove        bool
+		remaining     exported.Authorization
+	}{
+		{
+			name:          "generic",
+			authorization: types.NewGenericAuthorization(types.MsgTypeOf(send(1))),
+			msg:           send(1000),
+			accept:        true,
+			remaining:     types.NewGenericAuthorization(types.MsgTypeOf(send(1))),
+		},
+		{
+			name:          "send below limit",
+			authorization: types.NewSendAuthorization(atom(100)),
+			msg:           send(40),
+			accept:        true,
+			remaining:     types.NewSendAuthorization(atom(60)),
+		},
+		{
+			name:          "send entire limit",
+			authorization: types.NewSendAuthorization(atom(100)),
+			msg:           send(100),
+			accept:        true,
+			remove:        true,
+		},
+		{
+			name:          "send above limit",
+			authorization: types.NewSendAuthorization(atom(100)),
+			msg:           send(101),
+		},
+		{
+			name:          "send wrong message",
+			authorization: types.NewSendAuthorization(atom(100)),
+			msg:           delegate(10),
+		},
+		{
+			name:          "delegate below limit",
+			authorization: types.NewDelegateAuthorization(atom(100)),
+			msg:           delegate(30),
+			accept:        true,
+			remaining:     types.NewDelegateAuthorization(atom(70)),
+		},
+		{
+			name:          "delegate entire limit",
+			authorization: types.NewDelegateAuthorization(atom(100)),
+			msg:           delegate(100),
+			accept:        true,
+			remove:        true,
+		},
+		{
+			name:          "delegate above limit",
+			authorization: types.NewDelegateAuthorization(atom(100)),
+			msg:           delegate(101),
+		},
+		{
+			name:          "delegate wrong message",
+			authorization: types.NewDelegateAuthorization(atom(100)),
+			msg:           send(10),
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			require.NoError(t, tc.authorization.ValidateBasic())
+
+			remove, err := tc.authorization.Accept(tc.msg, abci.Header{})
+			if !tc.accept {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, tc.remove, remove)
+			if !remove {
+				require.Equal(t, tc.remaining, tc.authorization)
+			}
+		})
+	}
+}
+
+func TestAuthorizationValidateBasic(t *testing.T) {
+	require.Equal(t, "bank/send", types.NewSendAuthorization(nil).MsgType())
+	require.Equal(t, "staking/delegate", types.NewDelegateAuthorization(nil).MsgType())
+
+	require.Error(t, types.NewGenericAuthorization("").ValidateBasic())
+	require.Error(t, types.NewSendAuthorization(nil).ValidateBasic())
+	require.Error(t, types.NewSendAuthorization(sdk.Coins{sdk.Coin{Denom: "atom", Amount: sdk.NewInt(-1)}}).ValidateBasic())
+	require.Error(t, types.NewDelegateAuthorization(sdk.NewCoins()).ValidateBasic())
+}
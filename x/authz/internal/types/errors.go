@@ -0,0 +1,12 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/authz module sentinel errors
+var (
+	ErrNoAuthorizationFound  = sdkerrors.Register(ModuleName, 1, "authorization not found")
+	ErrInvalidExpirationTime = sdkerrors.Register(ModuleName, 2, "expiration time of authorization should be more than current time")
+	ErrInvalidAuthorization  = sdkerrors.Register(ModuleName, 3, "invalid authorization")
+)
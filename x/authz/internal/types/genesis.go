@@ -0,0 +1,31 @@
+package types
+
+// GenesisState defines the authz module's genesis state.
+type GenesisState struct {
+	Authorizations []AuthorizationGrant `json:"authorizations" yaml:"authorizations"`
+}
+
+func NewGenesisState(authorizations []AuthorizationGrant) GenesisState {
+	return GenesisState{
+		Authorizations: authorizations,
+	}
+}
+
+// DefaultGenesisState returns the authz module's default genesis state.
+func DefaultGenesisState() GenesisState {
+	return GenesisState{
+		Authorizations: []AuthorizationGrant{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	for _, a := range gs.Authorizations {
+		if err := a.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
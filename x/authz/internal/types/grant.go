@@ -0,0 +1,53 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz/exported"
+)
+
+// AuthorizationGrant is stored in the KVStore to record an authorization with
+// full context. A zero Expiration never expires.
+type AuthorizationGrant struct {
+	Granter       sdk.AccAddress         `json:"granter" yaml:"granter"`
+	Grantee       sdk.AccAddress         `json:"grantee" yaml:"grantee"`
+	Authorization exported.Authorization `json:"authorization" yaml:"authorization"`
+	Expiration    time.Time              `json:"expiration" yaml:"expiration"`
+}
+
+func NewAuthorizationGrant(
+	granter, grantee sdk.AccAddress, authorization exported.Authorization, expiration time.Time,
+) AuthorizationGrant {
+
+	return AuthorizationGrant{
+		Granter:       granter,
+		Grantee:       grantee,
+		Authorization: authorization,
+		Expiration:    expiration,
+	}
+}
+
+// IsExpired returns true if the grant has expired at the given time.
+func (g AuthorizationGrant) IsExpired(t time.Time) bool {
+	return !g.Expiration.IsZero() && !t.Before(g.Expiration)
+}
+
+// ValidateBasic performs basic validation on AuthorizationGrant
+func (g AuthorizationGrant) ValidateBasic() error {
+	if g.Granter.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing granter address")
+	}
+	if g.Grantee.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing grantee address")
+	}
+	if g.Granter.Equals(g.Grantee) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "granter and grantee cannot be the same")
+	}
+	if g.Authorization == nil {
+		return sdkerrors.Wrap(ErrInvalidAuthorization, "missing authorization")
+	}
+
+	return g.Authorization.ValidateBasic()
+}
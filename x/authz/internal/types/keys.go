@@ -0,0 +1,37 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = "authz"
+
+	// StoreKey is the store key string for authz
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for authz
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for authz
+	QuerierRoute = ModuleName
+)
+
+// KVStore key prefixes
+var (
+	// AuthorizationKeyPrefix is the prefix of the authorization grants
+	AuthorizationKeyPrefix = []byte{0x00}
+)
+
+// AuthorizationKey is the key under which the authorization of the given
+// message type from granter to grantee is stored.
+func AuthorizationKey(granter, grantee sdk.AccAddress, msgType string) []byte {
+	return append(GrantsPrefix(granter, grantee), []byte(msgType)...)
+}
+
+// GrantsPrefix returns a prefix to scan for all the authorizations from the
+// granter to the grantee.
+func GrantsPrefix(granter, grantee sdk.AccAddress) []byte {
+	return append(append(AuthorizationKeyPrefix, granter.Bytes()...), grantee.Bytes()...)
+}
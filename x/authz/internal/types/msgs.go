@@ -0,0 +1,184 @@
+package types
+
+import (
+	"encoding/json"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz/exported"
+)
+
+// Message types for the authz module
+const (
+	TypeMsgGrantAuthorization  = "grant_authorization"
+	TypeMsgRevokeAuthorization = "revoke_authorization"
+	TypeMsgExecAuthorized      = "exec_authorized"
+)
+
+var (
+	_ sdk.Msg = MsgGrantAuthorization{}
+	_ sdk.Msg = MsgRevokeAuthorization{}
+	_ sdk.Msg = MsgExecAuthorized{}
+)
+
+// MsgGrantAuthorization grants the Grantee the permission to execute messages
+// on behalf of the Granter as allowed by the Authorization, until Expiration.
+// If there was already an authorization for the same message type, this
+// overwrites it.
+type MsgGrantAuthorization struct {
+	Granter       sdk.AccAddress         `json:"granter" yaml:"granter"`
+	Grantee       sdk.AccAddress         `json:"grantee" yaml:"grantee"`
+	Authorization exported.Authorization `json:"authorization" yaml:"authorization"`
+	Expiration    time.Time              `json:"expiration" yaml:"expiration"`
+}
+
+func NewMsgGrantAuthorization(
+	granter, grantee sdk.AccAddress, authorization exported.Authorization, expiration time.Time,
+) MsgGrantAuthorization {
+
+	return MsgGrantAuthorization{
+		Granter:       granter,
+		Grantee:       grantee,
+		Authorization: authorization,
+		Expiration:    expiration,
+	}
+}
+
+// Route returns the MsgGrantAuthorization's route.
+func (msg MsgGrantAuthorization) Route() string { return RouterKey }
+
+// Type returns the MsgGrantAuthorization's type.
+func (msg MsgGrantAuthorization) Type() string { return TypeMsgGrantAuthorization }
+
+// ValidateBasic performs basic (non-state-dependant) validation on a
+// MsgGrantAuthorization.
+func (msg MsgGrantAuthorization) ValidateBasic() error {
+	return NewAuthorizationGrant(msg.Granter, msg.Grantee, msg.Authorization, msg.Expiration).ValidateBasic()
+}
+
+// GetSignBytes returns the raw bytes a signer is expected to sign when granting
+// an authorization.
+func (msg MsgGrantAuthorization) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners returns the granter as the single expected signer.
+func (msg MsgGrantAuthorization) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter}
+}
+
+// MsgRevokeAuthorization revokes the Grantee's permission to execute messages
+// of the given type on behalf of the Granter.
+type MsgRevokeAuthorization struct {
+	Granter sdk.AccAddress `json:"granter" yaml:"granter"`
+	Grantee sdk.AccAddress `json:"grantee" yaml:"grantee"`
+	// AuthorizationMsgType is the type of the messages the authorization applies to
+	AuthorizationMsgType string `json:"authorization_msg_type" yaml:"authorization_msg_type"`
+}
+
+func NewMsgRevokeAuthorization(granter, grantee sdk.AccAddress, msgType string) MsgRevokeAuthorization {
+	return MsgRevokeAuthorization{
+		Granter:              granter,
+		Grantee:              grantee,
+		AuthorizationMsgType: msgType,
+	}
+}
+
+// Route returns the MsgRevokeAuthorization's route.
+func (msg MsgRevokeAuthorization) Route() string { return RouterKey }
+
+// Type returns the MsgRevokeAuthorization's type.
+func (msg MsgRevokeAuthorization) Type() string { return TypeMsgRevokeAuthorization }
+
+// ValidateBasic performs basic (non-state-dependant) validation on a
+// MsgRevokeAuthorization.
+func (msg MsgRevokeAuthorization) ValidateBasic() error {
+	if msg.Granter.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing granter address")
+	}
+	if msg.Grantee.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing grantee address")
+	}
+	if msg.AuthorizationMsgType == "" {
+		return sdkerrors.Wrap(ErrInvalidAuthorization, "missing authorization message type")
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the raw bytes a signer is expected to sign when revoking
+// an authorization.
+func (msg MsgRevokeAuthorization) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners returns the granter as the single expected signer.
+func (msg MsgRevokeAuthorization) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter}
+}
+
+// MsgExecAuthorized executes Msgs on behalf of their signers, each of whom must
+// have granted the Grantee an authorization for the respective message type.
+type MsgExecAuthorized struct {
+	Grantee sdk.AccAddress `json:"grantee" yaml:"grantee"`
+	Msgs    []sdk.Msg      `json:"msgs" yaml:"msgs"`
+}
+
+func NewMsgExecAuthorized(grantee sdk.AccAddress, msgs []sdk.Msg) MsgExecAuthorized {
+	return MsgExecAuthorized{
+		Grantee: grantee,
+		Msgs:    msgs,
+	}
+}
+
+// Route returns the MsgExecAuthorized's route.
+func (msg MsgExecAuthorized) Route() string { return RouterKey }
+
+// Type returns the MsgExecAuthorized's type.
+func (msg MsgExecAuthorized) Type() string { return TypeMsgExecAuthorized }
+
+// ValidateBasic performs basic (non-state-dependant) validation on a
+// MsgExecAuthorized, including the validation of the wrapped messages.
+func (msg MsgExecAuthorized) ValidateBasic() error {
+	if msg.Grantee.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing grantee address")
+	}
+	if len(msg.Msgs) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "no messages to execute")
+	}
+
+	for _, m := range msg.Msgs {
+		if len(m.GetSigners()) != 1 {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "message %s must have exactly one signer", MsgTypeOf(m))
+		}
+		if err := m.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the raw bytes a signer is expected to sign when executing
+// messages on behalf of their signers. The sign bytes of the wrapped messages
+// are embedded so that their concrete types need not be registered with the
+// authz module's codec.
+func (msg MsgExecAuthorized) GetSignBytes() []byte {
+	msgsBytes := make([]json.RawMessage, len(msg.Msgs))
+	for i, m := range msg.Msgs {
+		msgsBytes[i] = json.RawMessage(m.GetSignBytes())
+	}
+
+	bz := ModuleCdc.MustMarshalJSON(struct {
+		Grantee sdk.AccAddress    `json:"grantee"`
+		Msgs    []json.RawMessage `json:"msgs"`
+	}{msg.Grantee, msgsBytes})
+
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the grantee as the single expected signer.
+func (msg MsgExecAuthorized) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Grantee}
+}
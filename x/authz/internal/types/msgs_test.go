@@ -0,0 +1,103 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz/internal/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+)
+
+func TestMsgGrantAuthorization(t *testing.T) {
+	granter := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	grantee := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	authorization := types.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("atom", 10)))
+	expiration := time.Now().Add(time.Hour)
+
+	testCases := map[string]struct {
+		msg   types.MsgGrantAuthorization
+		valid bool
+	}{
+		"valid":                 {types.NewMsgGrantAuthorization(granter, grantee, authorization, expiration), true},
+		"no expiration":         {types.NewMsgGrantAuthorization(granter, grantee, authorization, time.Time{}), true},
+		"missing granter":       {types.NewMsgGrantAuthorization(nil, grantee, authorization, expiration), false},
+		"missing grantee":       {types.NewMsgGrantAuthorization(granter, nil, authorization, expiration), false},
+		"self grant":            {types.NewMsgGrantAuthorization(granter, granter, authorization, expiration), false},
+		"missing authorization": {types.NewMsgGrantAuthorization(granter, grantee, nil, expiration), false},
+		"invalid authorization": {
+			types.NewMsgGrantAuthorization(granter, grantee, types.NewSendAuthorization(nil), expiration),
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, []sdk.AccAddress{granter}, tc.msg.GetSigners())
+		})
+	}
+}
+
+func TestMsgRevokeAuthorization(t *testing.T) {
+	granter := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	grantee := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	require.NoError(t, types.NewMsgRevokeAuthorization(granter, grantee, "bank/send").ValidateBasic())
+	require.Error(t, types.NewMsgRevokeAuthorization(nil, grantee, "bank/send").ValidateBasic())
+	require.Error(t, types.NewMsgRevokeAuthorization(granter, nil, "bank/send").ValidateBasic())
+	require.Error(t, types.NewMsgRevokeAuthorization(granter, grantee, "").ValidateBasic())
+}
+
+func TestMsgExecAuthorized(t *testing.T) {
+	granter := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	grantee := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	send := bank.NewMsgSend(granter, grantee, sdk.NewCoins(sdk.NewInt64Coin("atom", 10)))
+
+	testCases := map[string]struct {
+		msg   types.MsgExecAuthorized
+		valid bool
+	}{
+		"valid":           {types.NewMsgExecAuthorized(grantee, []sdk.Msg{send}), true},
+		"missing grantee": {types.NewMsgExecAuthorized(nil, []sdk.Msg{send}), false},
+		"no messages":     {types.NewMsgExecAuthorized(grantee, nil), false},
+		"invalid message": {
+			types.NewMsgExecAuthorized(grantee, []sdk.Msg{bank.NewMsgSend(granter, grantee, nil)}),
+			false,
+		},
+		"multiple signers": {
+			types.NewMsgExecAuthorized(grantee, []sdk.Msg{
+				bank.NewMsgMultiSend(
+					[]bank.Input{bank.NewInput(granter, send.Amount), bank.NewInput(grantee, send.Amount)},
+					[]bank.Output{bank.NewOutput(granter, send.Amount.Add(send.Amount...))},
+				),
+			}),
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, []sdk.AccAddress{grantee}, tc.msg.GetSigners())
+			require.Contains(t, string(tc.msg.GetSignBytes()), string(send.GetSignBytes()))
+		})
+	}
+}
@@ -52,6 +52,12 @@ var (
 	ParamStoreKeySendEnabled    = types.ParamStoreKeySendEnabled
 	BalancesPrefix              = types.BalancesPrefix
 	AddressFromBalancesStore    = types.AddressFromBalancesStore
+
+	NewQueryAllBalancesResponse = types.NewQueryAllBalancesResponse
+
+	NewSendEnabled                = types.NewSendEnabled
+	IsSendEnabledDenom            = types.IsSendEnabledDenom
+	ParamStoreKeyDenomSendEnabled = types.ParamStoreKeyDenomSendEnabled
 )
 
 type (
@@ -70,4 +76,8 @@ type (
 	QueryBalanceParams      = types.QueryBalanceParams
 	QueryAllBalancesParams  = types.QueryAllBalancesParams
 	GenesisBalancesIterator = types.GenesisBalancesIterator
+
+	QueryAllBalancesResponse = types.QueryAllBalancesResponse
+
+	SendEnabled = types.SendEnabled
 )
@@ -56,7 +56,12 @@ func GetBalancesCmd(cdc *codec.Codec) *cobra.Command {
 
 			denom := viper.GetString(flagDenom)
 			if denom == "" {
-				params = types.NewQueryAllBalancesParams(addr)
+				pageReq, err := client.ReadPageRequest()
+				if err != nil {
+					return err
+				}
+
+				params = types.NewQueryAllBalancesParams(addr, pageReq)
 				route = fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAllBalances)
 			} else {
 				params = types.NewQueryBalanceParams(addr, denom)
@@ -74,7 +79,7 @@ func GetBalancesCmd(cdc *codec.Codec) *cobra.Command {
 			}
 
 			if denom == "" {
-				var balances sdk.Coins
+				var balances types.QueryAllBalancesResponse
 				if err := cdc.UnmarshalJSON(res, &balances); err != nil {
 					return err
 				}
@@ -94,6 +99,7 @@ func GetBalancesCmd(cdc *codec.Codec) *cobra.Command {
 	}
 
 	cmd.Flags().String(flagDenom, "", "The specific balance denomination to query for")
+	flags.AddPaginationFlagsToCmd(cmd, "all balances")
 
 	return flags.GetCommands(cmd)[0]
 }
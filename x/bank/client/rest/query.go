@@ -38,7 +38,13 @@ func QueryBalancesRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 
 		denom := r.FormValue("denom")
 		if denom == "" {
-			params = types.NewQueryAllBalancesParams(addr)
+			pageReq, err := rest.ParsePageRequest(r)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+
+			params = types.NewQueryAllBalancesParams(addr, pageReq)
 			route = fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAllBalances)
 		} else {
 			params = types.NewQueryBalanceParams(addr, denom)
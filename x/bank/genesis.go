@@ -9,6 +9,7 @@ import (
 // InitGenesis initializes the bank module's state from a given genesis state.
 func InitGenesis(ctx sdk.Context, keeper Keeper, genState GenesisState) {
 	keeper.SetSendEnabled(ctx, genState.SendEnabled)
+	keeper.SetDenomSendEnabled(ctx, genState.DenomSendEnabled)
 
 	genState.Balances = SanitizeGenesisBalances(genState.Balances)
 	for _, balance := range genState.Balances {
@@ -43,5 +44,5 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
 		})
 	}
 
-	return NewGenesisState(keeper.GetSendEnabled(ctx), balances)
+	return NewGenesisState(keeper.GetSendEnabled(ctx), keeper.GetDenomSendEnabled(ctx), balances)
 }
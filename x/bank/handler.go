@@ -1,6 +1,7 @@
 package bank
 
 import (
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/bank/internal/keeper"
@@ -27,8 +28,8 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 
 // Handle MsgSend.
 func handleMsgSend(ctx sdk.Context, k keeper.Keeper, msg types.MsgSend) (*sdk.Result, error) {
-	if !k.GetSendEnabled(ctx) {
-		return nil, types.ErrSendDisabled
+	if err := k.SendEnabledCoins(ctx, msg.Amount...); err != nil {
+		return nil, err
 	}
 
 	if k.BlacklistedAddr(msg.ToAddress) {
@@ -40,6 +41,8 @@ func handleMsgSend(ctx sdk.Context, k keeper.Keeper, msg types.MsgSend) (*sdk.Re
 		return nil, err
 	}
 
+	emitTransferMetrics(msg.Type(), msg.Amount)
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
@@ -52,22 +55,20 @@ func handleMsgSend(ctx sdk.Context, k keeper.Keeper, msg types.MsgSend) (*sdk.Re
 
 // Handle MsgMultiSend.
 func handleMsgMultiSend(ctx sdk.Context, k keeper.Keeper, msg types.MsgMultiSend) (*sdk.Result, error) {
-	// NOTE: totalIn == totalOut should already have been checked
-	if !k.GetSendEnabled(ctx) {
-		return nil, types.ErrSendDisabled
-	}
-
-	for _, out := range msg.Outputs {
-		if k.BlacklistedAddr(out.Address) {
-			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive transactions", out.Address)
-		}
-	}
-
+	// NOTE: totalIn == totalOut should already have been checked, while the send
+	// enabled denominations and blacklisted outputs are checked by the keeper
 	err := k.InputOutputCoins(ctx, msg.Inputs, msg.Outputs)
 	if err != nil {
 		return nil, err
 	}
 
+	var amount sdk.Coins
+	for _, in := range msg.Inputs {
+		amount = amount.Add(in.Coins...)
+	}
+
+	emitTransferMetrics(msg.Type(), amount)
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
@@ -77,3 +78,20 @@ func handleMsgMultiSend(ctx sdk.Context, k keeper.Keeper, msg types.MsgMultiSend
 
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
+
+// emitTransferMetrics counts a successful transfer of the given message type,
+// along with the transferred amount per denomination.
+func emitTransferMetrics(msgType string, amount sdk.Coins) {
+	telemetry.IncrCounterWithLabels(
+		[]string{types.ModuleName, "transfers"}, 1, []telemetry.Label{telemetry.NewLabel("msg_type", msgType)},
+	)
+
+	for _, coin := range amount {
+		if coin.Amount.IsInt64() {
+			telemetry.IncrCounterWithLabels(
+				[]string{types.ModuleName, "transfers", "amount"}, float32(coin.Amount.Int64()),
+				[]telemetry.Label{telemetry.NewLabel("denom", coin.Denom)},
+			)
+		}
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
 	"github.com/cosmos/cosmos-sdk/x/bank/internal/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
@@ -135,8 +136,13 @@ type SendKeeper interface {
 
 	GetSendEnabled(ctx sdk.Context) bool
 	SetSendEnabled(ctx sdk.Context, enabled bool)
+	GetDenomSendEnabled(ctx sdk.Context) []types.SendEnabled
+	SetDenomSendEnabled(ctx sdk.Context, denomSendEnabled []types.SendEnabled)
+	IsSendEnabledCoin(ctx sdk.Context, coin sdk.Coin) bool
+	SendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) error
 
 	BlacklistedAddr(addr sdk.AccAddress) bool
+	AddBlacklistedAddrs(addrs ...sdk.AccAddress)
 }
 
 var _ SendKeeper = (*BaseSendKeeper)(nil)
@@ -159,19 +165,28 @@ func NewBaseSendKeeper(
 	cdc *codec.Codec, storeKey sdk.StoreKey, ak types.AccountKeeper, paramSpace params.Subspace, blacklistedAddrs map[string]bool,
 ) BaseSendKeeper {
 
+	// the keeper owns its copy of the list so that it can be extended through
+	// AddBlacklistedAddrs
+	blacklist := make(map[string]bool, len(blacklistedAddrs))
+	for addr, blacklisted := range blacklistedAddrs {
+		blacklist[addr] = blacklisted
+	}
+
 	return BaseSendKeeper{
 		BaseViewKeeper:   NewBaseViewKeeper(cdc, storeKey, ak),
 		cdc:              cdc,
 		ak:               ak,
 		storeKey:         storeKey,
 		paramSpace:       paramSpace,
-		blacklistedAddrs: blacklistedAddrs,
+		blacklistedAddrs: blacklist,
 	}
 }
 
 // InputOutputCoins performs multi-send functionality. It accepts a series of
 // inputs that correspond to a series of outputs. It returns an error if the
-// inputs and outputs don't lineup or if any single transfer of tokens fails.
+// inputs and outputs don't lineup, if any input denomination is not send
+// enabled, if any output address is blacklisted or if any single transfer of
+// tokens fails.
 func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input, outputs []types.Output) error {
 	// Safety check ensuring that when sending coins the keeper must maintain the
 	// Check supply invariant and validity of Coins.
@@ -179,6 +194,18 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 		return err
 	}
 
+	for _, in := range inputs {
+		if err := k.SendEnabledCoins(ctx, in.Coins...); err != nil {
+			return err
+		}
+	}
+
+	for _, out := range outputs {
+		if k.BlacklistedAddr(out.Address) {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive transactions", out.Address)
+		}
+	}
+
 	for _, in := range inputs {
 		_, err := k.SubtractCoins(ctx, in.Address, in.Coins)
 		if err != nil {
@@ -359,12 +386,55 @@ func (k BaseSendKeeper) SetSendEnabled(ctx sdk.Context, enabled bool) {
 	k.paramSpace.Set(ctx, types.ParamStoreKeySendEnabled, &enabled)
 }
 
+// GetDenomSendEnabled returns the per denomination overrides of SendEnabled.
+func (k BaseSendKeeper) GetDenomSendEnabled(ctx sdk.Context) []types.SendEnabled {
+	denomSendEnabled := []types.SendEnabled{}
+	k.paramSpace.GetIfExists(ctx, types.ParamStoreKeyDenomSendEnabled, &denomSendEnabled)
+	return denomSendEnabled
+}
+
+// SetDenomSendEnabled sets the per denomination overrides of SendEnabled.
+func (k BaseSendKeeper) SetDenomSendEnabled(ctx sdk.Context, denomSendEnabled []types.SendEnabled) {
+	k.paramSpace.Set(ctx, types.ParamStoreKeyDenomSendEnabled, &denomSendEnabled)
+}
+
+// IsSendEnabledCoin returns whether transfers of the coin's denomination are
+// enabled, either through its own override or through SendEnabled.
+func (k BaseSendKeeper) IsSendEnabledCoin(ctx sdk.Context, coin sdk.Coin) bool {
+	return types.IsSendEnabledDenom(k.GetDenomSendEnabled(ctx), k.GetSendEnabled(ctx), coin.Denom)
+}
+
+// SendEnabledCoins returns an error if transfers of any of the given coins'
+// denominations are disabled.
+func (k BaseSendKeeper) SendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) error {
+	defaultEnabled := k.GetSendEnabled(ctx)
+	denomSendEnabled := k.GetDenomSendEnabled(ctx)
+
+	for _, coin := range coins {
+		if !types.IsSendEnabledDenom(denomSendEnabled, defaultEnabled, coin.Denom) {
+			return sdkerrors.Wrapf(types.ErrSendDisabled, "%s transfers are currently disabled", coin.Denom)
+		}
+	}
+
+	return nil
+}
+
 // BlacklistedAddr checks if a given address is blacklisted (i.e restricted from
 // receiving funds)
 func (k BaseSendKeeper) BlacklistedAddr(addr sdk.AccAddress) bool {
 	return k.blacklistedAddrs[addr.String()]
 }
 
+// AddBlacklistedAddrs restricts the given addresses from receiving funds. The
+// list is shared by all copies of the keeper and is not part of the state, so
+// it must only be extended while wiring the application, before the chain
+// starts.
+func (k BaseSendKeeper) AddBlacklistedAddrs(addrs ...sdk.AccAddress) {
+	for _, addr := range addrs {
+		k.blacklistedAddrs[addr.String()] = true
+	}
+}
+
 var _ ViewKeeper = (*BaseViewKeeper)(nil)
 
 // ViewKeeper defines a module interface that facilitates read only access to
@@ -374,6 +444,7 @@ type ViewKeeper interface {
 	HasBalance(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coin) bool
 
 	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	GetPaginatedBalances(ctx sdk.Context, addr sdk.AccAddress, pageReq *query.PageRequest) (sdk.Coins, *query.PageResponse, error)
 	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
 
 	LockedCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
@@ -420,6 +491,34 @@ func (k BaseViewKeeper) GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk
 	return balances.Sort()
 }
 
+// GetPaginatedBalances returns a single page of the account balances for the
+// given account address. Balances are stored by denomination and are thus
+// returned sorted.
+func (k BaseViewKeeper) GetPaginatedBalances(
+	ctx sdk.Context, addr sdk.AccAddress, pageReq *query.PageRequest,
+) (sdk.Coins, *query.PageResponse, error) {
+
+	store := ctx.KVStore(k.storeKey)
+	balancesStore := prefix.NewStore(store, types.BalancesPrefix)
+	accountStore := prefix.NewStore(balancesStore, addr.Bytes())
+
+	balances := sdk.NewCoins()
+	pageRes, err := query.Paginate(accountStore, pageReq, func(_, value []byte) error {
+		var balance sdk.Coin
+		if err := k.cdc.UnmarshalBinaryBare(value, &balance); err != nil {
+			return err
+		}
+
+		balances = append(balances, balance)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return balances, pageRes, nil
+}
+
 // GetBalance returns the balance of a specific denomination for a given account
 // by address.
 func (k BaseViewKeeper) GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin {
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"errors"
 	"testing"
 	"time"
 
@@ -11,6 +12,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
 	"github.com/cosmos/cosmos-sdk/x/bank/internal/types"
@@ -213,6 +215,51 @@ func (suite *IntegrationTestSuite) TestSendEnabled() {
 	suite.Require().Equal(enabled, app.BankKeeper.GetSendEnabled(ctx))
 }
 
+func (suite *IntegrationTestSuite) TestDenomSendEnabled() {
+	app, ctx := suite.app, suite.ctx
+	suite.Require().Empty(app.BankKeeper.GetDenomSendEnabled(ctx))
+	suite.Require().True(app.BankKeeper.IsSendEnabledCoin(ctx, newFooCoin(1)))
+
+	denomSendEnabled := []types.SendEnabled{types.NewSendEnabled(fooDenom, false)}
+	app.BankKeeper.SetDenomSendEnabled(ctx, denomSendEnabled)
+	suite.Require().Equal(denomSendEnabled, app.BankKeeper.GetDenomSendEnabled(ctx))
+	suite.Require().False(app.BankKeeper.IsSendEnabledCoin(ctx, newFooCoin(1)))
+	suite.Require().True(app.BankKeeper.IsSendEnabledCoin(ctx, newBarCoin(1)))
+	suite.Require().True(errors.Is(app.BankKeeper.SendEnabledCoins(ctx, newBarCoin(1), newFooCoin(1)), types.ErrSendDisabled))
+
+	// the overrides take precedence over the module wide parameter
+	app.BankKeeper.SetSendEnabled(ctx, false)
+	app.BankKeeper.SetDenomSendEnabled(ctx, []types.SendEnabled{types.NewSendEnabled(barDenom, true)})
+	suite.Require().False(app.BankKeeper.IsSendEnabledCoin(ctx, newFooCoin(1)))
+	suite.Require().NoError(app.BankKeeper.SendEnabledCoins(ctx, newBarCoin(1)))
+}
+
+func (suite *IntegrationTestSuite) TestInputOutputCoinsRestrictions() {
+	app, ctx := suite.app, suite.ctx
+	balances := sdk.NewCoins(newFooCoin(90), newBarCoin(30))
+
+	addr1 := sdk.AccAddress([]byte("addr1"))
+	acc1 := app.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	app.AccountKeeper.SetAccount(ctx, acc1)
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, addr1, balances))
+
+	addr2 := sdk.AccAddress([]byte("addr2"))
+	inputs := []types.Input{{Address: addr1, Coins: sdk.NewCoins(newFooCoin(30))}}
+	outputs := []types.Output{{Address: addr2, Coins: sdk.NewCoins(newFooCoin(30))}}
+
+	app.BankKeeper.SetDenomSendEnabled(ctx, []types.SendEnabled{types.NewSendEnabled(fooDenom, false)})
+	err := app.BankKeeper.InputOutputCoins(ctx, inputs, outputs)
+	suite.Require().True(errors.Is(err, types.ErrSendDisabled))
+
+	app.BankKeeper.SetDenomSendEnabled(ctx, []types.SendEnabled{})
+	app.BankKeeper.AddBlacklistedAddrs(addr2)
+	suite.Require().True(app.BankKeeper.BlacklistedAddr(addr2))
+	err = app.BankKeeper.InputOutputCoins(ctx, inputs, outputs)
+	suite.Require().True(errors.Is(err, sdkerrors.ErrUnauthorized))
+
+	suite.Require().Equal(balances, app.BankKeeper.GetAllBalances(ctx, addr1))
+}
+
 func (suite *IntegrationTestSuite) TestHasBalance() {
 	app, ctx := suite.app, suite.ctx
 	addr := sdk.AccAddress([]byte("addr1"))
@@ -49,9 +49,12 @@ func queryAllBalance(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte,
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
 	}
 
-	balances := k.GetAllBalances(ctx, params.Address)
+	balances, pageRes, err := k.GetPaginatedBalances(ctx, params.Address, params.Pagination)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
 
-	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, balances)
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, types.NewQueryAllBalancesResponse(balances, pageRes))
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
 	}
@@ -6,6 +6,7 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/internal/keeper"
 	"github.com/cosmos/cosmos-sdk/x/bank/internal/types"
@@ -61,14 +62,14 @@ func (suite *IntegrationTestSuite) TestQuerier_QueryAllBalances() {
 	suite.Require().NotNil(err)
 	suite.Require().Nil(res)
 
-	req.Data = app.Codec().MustMarshalJSON(types.NewQueryAllBalancesParams(addr))
+	req.Data = app.Codec().MustMarshalJSON(types.NewQueryAllBalancesParams(addr, nil))
 	res, err = querier(ctx, []string{types.QueryAllBalances}, req)
 	suite.Require().NoError(err)
 	suite.Require().NotNil(res)
 
-	var balances sdk.Coins
+	var balances types.QueryAllBalancesResponse
 	suite.Require().NoError(app.Codec().UnmarshalJSON(res, &balances))
-	suite.True(balances.IsZero())
+	suite.True(balances.Balances.IsZero())
 
 	origCoins := sdk.NewCoins(newFooCoin(50), newBarCoin(30))
 	acc := app.AccountKeeper.NewAccountWithAddress(ctx, addr)
@@ -80,7 +81,22 @@ func (suite *IntegrationTestSuite) TestQuerier_QueryAllBalances() {
 	suite.Require().NoError(err)
 	suite.Require().NotNil(res)
 	suite.Require().NoError(app.Codec().UnmarshalJSON(res, &balances))
-	suite.True(balances.IsEqual(origCoins))
+	suite.True(balances.Balances.IsEqual(origCoins))
+	suite.Require().Equal(uint64(2), balances.Pagination.Total)
+
+	req.Data = app.Codec().MustMarshalJSON(types.NewQueryAllBalancesParams(addr, query.NewPageRequest(nil, 0, 1, false)))
+	res, err = querier(ctx, []string{types.QueryAllBalances}, req)
+	suite.Require().NoError(err)
+	suite.Require().NoError(app.Codec().UnmarshalJSON(res, &balances))
+	suite.True(balances.Balances.IsEqual(sdk.NewCoins(newBarCoin(30))))
+	suite.Require().NotNil(balances.Pagination.NextKey)
+
+	req.Data = app.Codec().MustMarshalJSON(types.NewQueryAllBalancesParams(addr, query.NewPageRequest(balances.Pagination.NextKey, 0, 1, false)))
+	res, err = querier(ctx, []string{types.QueryAllBalances}, req)
+	suite.Require().NoError(err)
+	suite.Require().NoError(app.Codec().UnmarshalJSON(res, &balances))
+	suite.True(balances.Balances.IsEqual(sdk.NewCoins(newFooCoin(50))))
+	suite.Require().Nil(balances.Pagination.NextKey)
 }
 
 func (suite *IntegrationTestSuite) TestQuerierRouteNotFound() {
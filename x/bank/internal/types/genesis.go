@@ -14,8 +14,9 @@ var _ exported.GenesisBalance = (*Balance)(nil)
 
 // GenesisState defines the bank module's genesis state.
 type GenesisState struct {
-	SendEnabled bool      `json:"send_enabled" yaml:"send_enabled"`
-	Balances    []Balance `json:"balances" yaml:"balances"`
+	SendEnabled      bool          `json:"send_enabled" yaml:"send_enabled"`
+	DenomSendEnabled []SendEnabled `json:"denom_send_enabled" yaml:"denom_send_enabled"`
+	Balances         []Balance     `json:"balances" yaml:"balances"`
 }
 
 // Balance defines an account address and balance pair used in the bank module's
@@ -49,16 +50,18 @@ func SanitizeGenesisBalances(balances []Balance) []Balance {
 }
 
 // NewGenesisState creates a new genesis state.
-func NewGenesisState(sendEnabled bool, balances []Balance) GenesisState {
-	return GenesisState{SendEnabled: sendEnabled, Balances: balances}
+func NewGenesisState(sendEnabled bool, denomSendEnabled []SendEnabled, balances []Balance) GenesisState {
+	return GenesisState{SendEnabled: sendEnabled, DenomSendEnabled: denomSendEnabled, Balances: balances}
 }
 
 // DefaultGenesisState returns a default bank module genesis state.
-func DefaultGenesisState() GenesisState { return NewGenesisState(true, []Balance{}) }
+func DefaultGenesisState() GenesisState { return NewGenesisState(true, []SendEnabled{}, []Balance{}) }
 
 // ValidateGenesis performs basic validation of bank genesis data returning an
 // error for any failed validation criteria.
-func ValidateGenesis(data GenesisState) error { return nil }
+func ValidateGenesis(data GenesisState) error {
+	return validateDenomSendEnabled(data.DenomSendEnabled)
+}
 
 // GetGenesisStateFromAppState returns x/bank GenesisState given raw application
 // genesis state.
@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
 )
 
@@ -13,16 +14,52 @@ const (
 	DefaultSendEnabled = true
 )
 
-// ParamStoreKeySendEnabled is store's key for SendEnabled
-var ParamStoreKeySendEnabled = []byte("sendenabled")
+var (
+	// ParamStoreKeySendEnabled is store's key for SendEnabled
+	ParamStoreKeySendEnabled = []byte("sendenabled")
+	// ParamStoreKeyDenomSendEnabled is store's key for the per denomination
+	// SendEnabled overrides
+	ParamStoreKeyDenomSendEnabled = []byte("denomsendenabled")
+)
+
+// SendEnabled enables or disables the transfer of a single denomination. It
+// overrides the module wide SendEnabled parameter for that denomination.
+type SendEnabled struct {
+	Denom   string `json:"denom" yaml:"denom"`
+	Enabled bool   `json:"enabled" yaml:"enabled"`
+}
+
+// NewSendEnabled creates a new SendEnabled instance.
+func NewSendEnabled(denom string, enabled bool) SendEnabled {
+	return SendEnabled{Denom: denom, Enabled: enabled}
+}
+
+// String implements the Stringer interface.
+func (se SendEnabled) String() string {
+	return fmt.Sprintf("%s: %t", se.Denom, se.Enabled)
+}
 
 // ParamKeyTable type declaration for parameters
 func ParamKeyTable() params.KeyTable {
 	return params.NewKeyTable(
 		params.NewParamSetPair(ParamStoreKeySendEnabled, false, validateSendEnabled),
+		params.NewParamSetPair(ParamStoreKeyDenomSendEnabled, []SendEnabled{}, validateDenomSendEnabled),
 	)
 }
 
+// IsSendEnabledDenom returns whether transfers of the given denomination are
+// enabled, falling back to defaultEnabled when the denomination has no entry
+// in denomSendEnabled.
+func IsSendEnabledDenom(denomSendEnabled []SendEnabled, defaultEnabled bool, denom string) bool {
+	for _, se := range denomSendEnabled {
+		if se.Denom == denom {
+			return se.Enabled
+		}
+	}
+
+	return defaultEnabled
+}
+
 func validateSendEnabled(i interface{}) error {
 	_, ok := i.(bool)
 	if !ok {
@@ -31,3 +68,25 @@ func validateSendEnabled(i interface{}) error {
 
 	return nil
 }
+
+func validateDenomSendEnabled(i interface{}) error {
+	denomSendEnabled, ok := i.([]SendEnabled)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seenDenoms := make(map[string]bool, len(denomSendEnabled))
+	for _, se := range denomSendEnabled {
+		if err := sdk.ValidateDenom(se.Denom); err != nil {
+			return err
+		}
+
+		if seenDenoms[se.Denom] {
+			return fmt.Errorf("duplicate send enabled denomination: %s", se.Denom)
+		}
+
+		seenDenoms[se.Denom] = true
+	}
+
+	return nil
+}
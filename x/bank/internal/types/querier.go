@@ -2,6 +2,7 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 // Querier path constants
@@ -23,10 +24,23 @@ func NewQueryBalanceParams(addr sdk.AccAddress, denom string) QueryBalanceParams
 
 // QueryAllBalancesParams defines the params for querying all account balances
 type QueryAllBalancesParams struct {
-	Address sdk.AccAddress
+	Address    sdk.AccAddress
+	Pagination *query.PageRequest
 }
 
 // NewQueryAllBalancesParams creates a new instance of QueryAllBalancesParams.
-func NewQueryAllBalancesParams(addr sdk.AccAddress) QueryAllBalancesParams {
-	return QueryAllBalancesParams{Address: addr}
+func NewQueryAllBalancesParams(addr sdk.AccAddress, pageReq *query.PageRequest) QueryAllBalancesParams {
+	return QueryAllBalancesParams{Address: addr, Pagination: pageReq}
+}
+
+// QueryAllBalancesResponse defines the response of the all account balances
+// query.
+type QueryAllBalancesResponse struct {
+	Balances   sdk.Coins           `json:"balances"`
+	Pagination *query.PageResponse `json:"pagination"`
+}
+
+// NewQueryAllBalancesResponse creates a new instance of QueryAllBalancesResponse.
+func NewQueryAllBalancesResponse(balances sdk.Coins, pageRes *query.PageResponse) QueryAllBalancesResponse {
+	return QueryAllBalancesResponse{Balances: balances, Pagination: pageRes}
 }
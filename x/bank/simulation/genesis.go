@@ -43,7 +43,7 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { sendEnabled = GenSendEnabled(r) },
 	)
 
-	bankGenesis := types.NewGenesisState(sendEnabled, RandomGenesisBalances(simState))
+	bankGenesis := types.NewGenesisState(sendEnabled, []types.SendEnabled{}, RandomGenesisBalances(simState))
 
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(bankGenesis)
 }
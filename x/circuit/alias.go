@@ -0,0 +1,62 @@
+package circuit
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/circuit/internal/keeper"
+	"github.com/cosmos/cosmos-sdk/x/circuit/internal/types"
+)
+
+// nolint
+
+const (
+	ModuleName                 = types.ModuleName
+	StoreKey                   = types.StoreKey
+	RouterKey                  = types.RouterKey
+	QuerierRoute               = types.QuerierRoute
+	DefaultParamspace          = types.DefaultParamspace
+	QueryParameters            = types.QueryParameters
+	QueryDisabledMsgTypes      = types.QueryDisabledMsgTypes
+	TypeMsgDisableMsgTypes     = types.TypeMsgDisableMsgTypes
+	TypeMsgEnableMsgTypes      = types.TypeMsgEnableMsgTypes
+	ProposalTypeCircuitBreaker = types.ProposalTypeCircuitBreaker
+	EventTypeDisableMsgType    = types.EventTypeDisableMsgType
+	EventTypeEnableMsgType     = types.EventTypeEnableMsgType
+	AttributeKeyMsgType        = types.AttributeKeyMsgType
+	AttributeValueCategory     = types.AttributeValueCategory
+)
+
+var (
+	NewKeeper  = keeper.NewKeeper
+	NewQuerier = keeper.NewQuerier
+
+	RegisterCodec             = types.RegisterCodec
+	ModuleCdc                 = types.ModuleCdc
+	MsgTypeOf                 = types.MsgTypeOf
+	ValidateMsgType           = types.ValidateMsgType
+	ValidateMsgTypes          = types.ValidateMsgTypes
+	NewParams                 = types.NewParams
+	DefaultParams             = types.DefaultParams
+	ParamKeyTable             = types.ParamKeyTable
+	NewMsgDisableMsgTypes     = types.NewMsgDisableMsgTypes
+	NewMsgEnableMsgTypes      = types.NewMsgEnableMsgTypes
+	NewCircuitBreakerProposal = types.NewCircuitBreakerProposal
+	NewGenesisState           = types.NewGenesisState
+	DefaultGenesisState       = types.DefaultGenesisState
+	DisabledMsgTypeKey        = types.DisabledMsgTypeKey
+
+	KeyAuthorities           = types.KeyAuthorities
+	DisabledMsgTypeKeyPrefix = types.DisabledMsgTypeKeyPrefix
+
+	ErrUnauthorized   = types.ErrUnauthorized
+	ErrAlwaysAllowed  = types.ErrAlwaysAllowed
+	ErrInvalidMsgType = types.ErrInvalidMsgType
+)
+
+type (
+	Keeper = keeper.Keeper
+
+	Params                 = types.Params
+	MsgDisableMsgTypes     = types.MsgDisableMsgTypes
+	MsgEnableMsgTypes      = types.MsgEnableMsgTypes
+	CircuitBreakerProposal = types.CircuitBreakerProposal
+	GenesisState           = types.GenesisState
+)
@@ -0,0 +1,104 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/circuit/internal/types"
+)
+
+// GetQueryCmd returns the CLI command with all circuit module query commands
+// mounted.
+func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the circuit module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	queryCmd.AddCommand(flags.GetCommands(
+		GetCmdQueryParams(queryRoute, cdc),
+		GetCmdQueryDisabledMsgTypes(queryRoute, cdc),
+	)...)
+
+	return queryCmd
+}
+
+// GetCmdQueryParams returns the command to query the circuit module
+// parameters.
+func GetCmdQueryParams(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "params",
+		Short: "Query the current circuit parameters",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the current circuit parameters, i.e. the circuit breaker authorities:
+
+Example:
+$ %s query %s params
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryParameters)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var params types.Params
+			if err := cdc.UnmarshalJSON(res, &params); err != nil {
+				return fmt.Errorf("failed to unmarshal params: %w", err)
+			}
+
+			return cliCtx.PrintOutput(params)
+		},
+	}
+}
+
+// GetCmdQueryDisabledMsgTypes returns the command to query the disabled
+// message types.
+func GetCmdQueryDisabledMsgTypes(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "disabled",
+		Short: "Query the disabled message types",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the message types whose dispatch is disabled:
+
+Example:
+$ %s query %s disabled
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryDisabledMsgTypes)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var msgTypes []string
+			if err := cdc.UnmarshalJSON(res, &msgTypes); err != nil {
+				return fmt.Errorf("failed to unmarshal disabled message types: %w", err)
+			}
+
+			return cliCtx.PrintOutput(msgTypes)
+		},
+	}
+}
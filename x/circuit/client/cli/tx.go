@@ -0,0 +1,182 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	"github.com/cosmos/cosmos-sdk/x/circuit/internal/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+)
+
+const (
+	flagDisable = "disable"
+	flagEnable  = "enable"
+)
+
+// GetTxCmd returns the transaction commands for the circuit module.
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	circuitTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Circuit breaker transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	circuitTxCmd.AddCommand(flags.PostCommands(
+		GetCmdDisableMsgTypes(cdc),
+		GetCmdEnableMsgTypes(cdc),
+	)...)
+
+	return circuitTxCmd
+}
+
+// GetCmdDisableMsgTypes returns the command to disable message types.
+func GetCmdDisableMsgTypes(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "disable [msg-type]...",
+		Short: "Disable the dispatch of message types",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Disable the dispatch of message types, each identified either by a message
+route, covering all the messages of the route, or by "<route>/<type>". The
+signer must be a circuit breaker authority.
+
+Example:
+$ %s tx %s disable bank staking/delegate --from mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			msg := types.NewMsgDisableMsgTypes(cliCtx.GetFromAddress(), args)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdEnableMsgTypes returns the command to enable disabled message types.
+func GetCmdEnableMsgTypes(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "enable [msg-type]...",
+		Short: "Enable the dispatch of disabled message types",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Enable the dispatch of disabled message types, each identified either by a
+message route or by "<route>/<type>". The signer must be a circuit breaker
+authority.
+
+Example:
+$ %s tx %s enable bank staking/delegate --from mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			msg := types.NewMsgEnableMsgTypes(cliCtx.GetFromAddress(), args)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdSubmitCircuitBreakerProposal implements a command handler for
+// submitting a circuit breaker proposal transaction.
+func GetCmdSubmitCircuitBreakerProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "circuit-breaker (--disable [msg-type],...) (--enable [msg-type],...) [flags]",
+		Args:  cobra.NoArgs,
+		Short: "Submit a circuit breaker proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to disable and enable message types along with an initial
+deposit. Message types are identified either by a message route or by
+"<route>/<type>".
+
+Example:
+$ %s tx gov submit-proposal circuit-breaker --disable bank/send --title "Pause sends" --description "..." --deposit 1000stake --from mykey
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+			from := cliCtx.GetFromAddress()
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			disable, err := cmd.Flags().GetStringSlice(flagDisable)
+			if err != nil {
+				return err
+			}
+
+			enable, err := cmd.Flags().GetStringSlice(flagEnable)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoins(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewCircuitBreakerProposal(title, description, disable, enable)
+
+			msg := gov.NewMsgSubmitProposal(content, deposit, from)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	cmd.Flags().StringSlice(flagDisable, []string{}, "comma separated message types to disable")
+	cmd.Flags().StringSlice(flagEnable, []string{}, "comma separated message types to enable")
+
+	return cmd
+}
@@ -0,0 +1,41 @@
+/*
+Package circuit implements a Cosmos SDK module that acts as a circuit breaker,
+allowing specific message types to be paused, e.g. during an exploit, without
+halting the chain or coordinating a binary swap.
+
+Message types are identified either by a message route, e.g. "bank", covering
+all the messages of the route, or by a single "<route>/<type>", e.g.
+"bank/send". A disabled message is rejected by the BaseApp in both CheckTx and
+DeliverTx, including when it is dispatched by another module through the
+message router, e.g. by x/authz on behalf of a granter.
+
+Message types are disabled and enabled either by one of the authorities set in
+the module parameters through MsgDisableMsgTypes and MsgEnableMsgTypes, or by
+governance through a CircuitBreakerProposal. The application chooses the
+message types that can never be disabled when creating the keeper. The
+circuit module's own messages are always allowed.
+
+A full setup of the circuit module may look something as follows:
+
+	ModuleBasics = module.NewBasicManager(
+	  // ...,
+	  gov.NewAppModuleBasic(
+	    // ...,
+	    circuitclient.ProposalHandler,
+	  ),
+	  circuit.AppModuleBasic{},
+	)
+
+	circuitKeeper := circuit.NewKeeper(
+	  app.cdc, keys[circuit.StoreKey], app.subspaces[circuit.ModuleName], gov.RouterKey,
+	)
+	app.SetCircuitBreaker(circuitKeeper)
+
+	govRouter.AddRoute(circuit.RouterKey, circuit.NewCircuitBreakerProposalHandler(circuitKeeper))
+
+	app.mm = module.NewManager(
+	  // ...
+	  circuit.NewAppModule(circuitKeeper),
+	)
+*/
+package circuit
@@ -0,0 +1,28 @@
+package circuit
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis initializes the circuit module's state from a provided genesis
+// state.
+func InitGenesis(ctx sdk.Context, k Keeper, gs GenesisState) {
+	if err := gs.Validate(); err != nil {
+		panic(fmt.Sprintf("failed to validate %s genesis state: %s", ModuleName, err))
+	}
+
+	k.SetParams(ctx, gs.Params)
+
+	for _, msgType := range gs.DisabledMsgTypes {
+		if err := k.DisableMsgType(ctx, msgType); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// ExportGenesis returns the circuit module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
+	return NewGenesisState(k.GetParams(ctx), k.GetDisabledMsgTypes(ctx))
+}
@@ -0,0 +1,36 @@
+package circuit_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit"
+)
+
+func TestImportExportGenesis(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Height: 1})
+
+	authority := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	genesis := circuit.NewGenesisState(
+		circuit.NewParams([]sdk.AccAddress{authority}), []string{"bank/send", "staking"},
+	)
+	bz := circuit.ModuleCdc.MustMarshalJSON(genesis)
+	require.NoError(t, circuit.AppModuleBasic{}.ValidateGenesis(bz))
+
+	circuit.InitGenesis(ctx, app.CircuitKeeper, genesis)
+	require.Equal(t, genesis, circuit.ExportGenesis(ctx, app.CircuitKeeper))
+
+	// invalid and always allowed message types are rejected
+	invalid := circuit.NewGenesisState(circuit.DefaultParams(), []string{"bank/"})
+	require.Error(t, invalid.Validate())
+	require.Panics(t, func() { circuit.InitGenesis(ctx, app.CircuitKeeper, invalid) })
+
+	alwaysAllowed := circuit.NewGenesisState(circuit.DefaultParams(), []string{"gov"})
+	require.Panics(t, func() { circuit.InitGenesis(ctx, app.CircuitKeeper, alwaysAllowed) })
+}
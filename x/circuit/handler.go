@@ -0,0 +1,100 @@
+package circuit
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// NewHandler returns a handler for the circuit module's messages.
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case MsgDisableMsgTypes:
+			return handleMsgDisableMsgTypes(ctx, k, msg)
+
+		case MsgEnableMsgTypes:
+			return handleMsgEnableMsgTypes(ctx, k, msg)
+
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", ModuleName, msg)
+		}
+	}
+}
+
+func handleMsgDisableMsgTypes(ctx sdk.Context, k Keeper, msg MsgDisableMsgTypes) (*sdk.Result, error) {
+	if !k.IsAuthority(ctx, msg.Authority) {
+		return nil, sdkerrors.Wrap(ErrUnauthorized, msg.Authority.String())
+	}
+
+	for _, msgType := range msg.MsgTypes {
+		if err := k.DisableMsgType(ctx, msgType); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Authority.String()),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgEnableMsgTypes(ctx sdk.Context, k Keeper, msg MsgEnableMsgTypes) (*sdk.Result, error) {
+	if !k.IsAuthority(ctx, msg.Authority) {
+		return nil, sdkerrors.Wrap(ErrUnauthorized, msg.Authority.String())
+	}
+
+	for _, msgType := range msg.MsgTypes {
+		if err := k.EnableMsgType(ctx, msgType); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Authority.String()),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// NewCircuitBreakerProposalHandler creates a governance handler to manage new
+// proposal types. It disables and enables the message types of a
+// CircuitBreakerProposal.
+func NewCircuitBreakerProposalHandler(k Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case CircuitBreakerProposal:
+			return handleCircuitBreakerProposal(ctx, k, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s proposal content type: %T", ModuleName, c)
+		}
+	}
+}
+
+func handleCircuitBreakerProposal(ctx sdk.Context, k Keeper, p CircuitBreakerProposal) error {
+	for _, msgType := range p.DisableMsgTypes {
+		if err := k.DisableMsgType(ctx, msgType); err != nil {
+			return err
+		}
+	}
+
+	for _, msgType := range p.EnableMsgTypes {
+		if err := k.EnableMsgType(ctx, msgType); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
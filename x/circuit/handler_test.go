@@ -0,0 +1,72 @@
+package circuit_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/circuit"
+)
+
+func TestHandleMsgs(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Height: 1})
+	addrs := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(10000))
+	authority, other := addrs[0], addrs[1]
+
+	app.CircuitKeeper.SetParams(ctx, circuit.NewParams([]sdk.AccAddress{authority}))
+	handler := circuit.NewHandler(app.CircuitKeeper)
+
+	// only authorities can disable message types
+	_, err := handler(ctx, circuit.NewMsgDisableMsgTypes(other, []string{"bank/send"}))
+	require.True(t, circuit.ErrUnauthorized.Is(err))
+
+	_, err = handler(ctx, circuit.NewMsgDisableMsgTypes(authority, []string{"bank/send"}))
+	require.NoError(t, err)
+	require.Equal(t, []string{"bank/send"}, app.CircuitKeeper.GetDisabledMsgTypes(ctx))
+
+	// always allowed message types cannot be disabled
+	_, err = handler(ctx, circuit.NewMsgDisableMsgTypes(authority, []string{"gov"}))
+	require.True(t, circuit.ErrAlwaysAllowed.Is(err))
+
+	// a disabled message dispatched through the router is rejected, also when
+	// it is executed on behalf of a granter
+	send := bank.NewMsgSend(other, authority, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)))
+	authzHandler := authz.NewHandler(app.AuthzKeeper)
+
+	_, err = authzHandler(ctx, authz.NewMsgExecAuthorized(other, []sdk.Msg{send}))
+	require.True(t, sdkerrors.ErrMsgDisabled.Is(err))
+
+	_, err = handler(ctx, circuit.NewMsgEnableMsgTypes(other, []string{"bank/send"}))
+	require.True(t, circuit.ErrUnauthorized.Is(err))
+
+	_, err = handler(ctx, circuit.NewMsgEnableMsgTypes(authority, []string{"bank/send"}))
+	require.NoError(t, err)
+	require.Empty(t, app.CircuitKeeper.GetDisabledMsgTypes(ctx))
+
+	_, err = authzHandler(ctx, authz.NewMsgExecAuthorized(other, []sdk.Msg{send}))
+	require.NoError(t, err)
+}
+
+func TestCircuitBreakerProposalHandler(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Height: 1})
+	handler := circuit.NewCircuitBreakerProposalHandler(app.CircuitKeeper)
+
+	proposal := circuit.NewCircuitBreakerProposal("title", "description", []string{"bank", "staking/delegate"}, nil)
+	require.NoError(t, handler(ctx, proposal))
+	require.Equal(t, []string{"bank", "staking/delegate"}, app.CircuitKeeper.GetDisabledMsgTypes(ctx))
+
+	proposal = circuit.NewCircuitBreakerProposal("title", "description", nil, []string{"bank"})
+	require.NoError(t, handler(ctx, proposal))
+	require.Equal(t, []string{"staking/delegate"}, app.CircuitKeeper.GetDisabledMsgTypes(ctx))
+
+	proposal = circuit.NewCircuitBreakerProposal("title", "description", []string{"circuit"}, nil)
+	require.True(t, circuit.ErrAlwaysAllowed.Is(handler(ctx, proposal)))
+}
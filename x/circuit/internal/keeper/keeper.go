@@ -0,0 +1,163 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/circuit/internal/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+var _ sdk.CircuitBreaker = Keeper{}
+
+// Keeper manages the message types whose dispatch is disabled. It implements
+// the sdk.CircuitBreaker interface, so it is meant to be set on the BaseApp
+// with SetCircuitBreaker.
+type Keeper struct {
+	cdc           *codec.Codec
+	storeKey      sdk.StoreKey
+	paramSpace    params.Subspace
+	alwaysAllowed map[string]bool
+}
+
+// NewKeeper creates a circuit Keeper. The message types in alwaysAllowed, each
+// either a message route or a single "<route>/<type>", can never be disabled,
+// e.g. so that light client updates keep flowing while other messages of the
+// same module are paused. The circuit module's own messages are always
+// allowed.
+func NewKeeper(
+	cdc *codec.Codec, storeKey sdk.StoreKey, paramSpace params.Subspace, alwaysAllowed ...string,
+) Keeper {
+
+	if err := types.ValidateMsgTypes(alwaysAllowed); err != nil {
+		panic(fmt.Sprintf("invalid always allowed message types: %s", err))
+	}
+
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	allowed := map[string]bool{types.RouterKey: true}
+	for _, msgType := range alwaysAllowed {
+		allowed[msgType] = true
+	}
+
+	return Keeper{
+		cdc:           cdc,
+		storeKey:      storeKey,
+		paramSpace:    paramSpace,
+		alwaysAllowed: allowed,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// IsAllowed implements the sdk.CircuitBreaker interface. A message is allowed
+// unless neither it nor its route is always allowed and either its type or its
+// route is disabled.
+func (k Keeper) IsAllowed(ctx sdk.Context, msg sdk.Msg) bool {
+	route, msgType := msg.Route(), types.MsgTypeOf(msg)
+	if k.alwaysAllowed[route] || k.alwaysAllowed[msgType] {
+		return true
+	}
+
+	return !k.IsDisabled(ctx, route) && !k.IsDisabled(ctx, msgType)
+}
+
+// IsAlwaysAllowed returns whether the given message type, or the route it
+// belongs to, can never be disabled.
+func (k Keeper) IsAlwaysAllowed(msgType string) bool {
+	route := strings.SplitN(msgType, "/", 2)[0]
+	return k.alwaysAllowed[route] || k.alwaysAllowed[msgType]
+}
+
+// IsDisabled returns whether the given message type identifier is disabled.
+func (k Keeper) IsDisabled(ctx sdk.Context, msgType string) bool {
+	return ctx.KVStore(k.storeKey).Has(types.DisabledMsgTypeKey(msgType))
+}
+
+// DisableMsgType stops the dispatch of the given message type, which is
+// either a message route or a single "<route>/<type>". Always allowed
+// message types cannot be disabled. Disabling a route does not affect the
+// always allowed message types of that route.
+func (k Keeper) DisableMsgType(ctx sdk.Context, msgType string) error {
+	if err := types.ValidateMsgType(msgType); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidMsgType, err.Error())
+	}
+
+	if k.IsAlwaysAllowed(msgType) {
+		return sdkerrors.Wrap(types.ErrAlwaysAllowed, msgType)
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.DisabledMsgTypeKey(msgType), []byte{0x01})
+
+	k.Logger(ctx).Info(fmt.Sprintf("disabled message type %s", msgType))
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDisableMsgType,
+			sdk.NewAttribute(types.AttributeKeyMsgType, msgType),
+		),
+	)
+
+	return nil
+}
+
+// EnableMsgType resumes the dispatch of the given message type. Enabling a
+// message type that is not disabled is a no-op.
+func (k Keeper) EnableMsgType(ctx sdk.Context, msgType string) error {
+	if err := types.ValidateMsgType(msgType); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidMsgType, err.Error())
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	key := types.DisabledMsgTypeKey(msgType)
+	if !store.Has(key) {
+		return nil
+	}
+
+	store.Delete(key)
+
+	k.Logger(ctx).Info(fmt.Sprintf("enabled message type %s", msgType))
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEnableMsgType,
+			sdk.NewAttribute(types.AttributeKeyMsgType, msgType),
+		),
+	)
+
+	return nil
+}
+
+// IterateDisabledMsgTypes iterates over all the disabled message types in the
+// store. Iteration stops when the callback returns true.
+func (k Keeper) IterateDisabledMsgTypes(ctx sdk.Context, cb func(msgType string) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.DisabledMsgTypeKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(string(iterator.Key()[len(types.DisabledMsgTypeKeyPrefix):])) {
+			break
+		}
+	}
+}
+
+// GetDisabledMsgTypes returns all the disabled message types.
+func (k Keeper) GetDisabledMsgTypes(ctx sdk.Context) []string {
+	msgTypes := []string{}
+	k.IterateDisabledMsgTypes(ctx, func(msgType string) bool {
+		msgTypes = append(msgTypes, msgType)
+		return false
+	})
+
+	return msgTypes
+}
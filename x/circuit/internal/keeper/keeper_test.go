@@ -0,0 +1,102 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/circuit/internal/keeper"
+	"github.com/cosmos/cosmos-sdk/x/circuit/internal/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app    *simapp.SimApp
+	ctx    sdk.Context
+	keeper keeper.Keeper
+
+	addrs []sdk.AccAddress
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.app = simapp.Setup(false)
+	suite.ctx = suite.app.BaseApp.NewContext(false, abci.Header{Height: 1})
+	suite.keeper = keeper.NewKeeper(
+		suite.app.Codec(), suite.app.GetKey(types.StoreKey), suite.app.GetSubspace(types.ModuleName),
+		"staking/delegate",
+	)
+
+	suite.addrs = simapp.AddTestAddrs(suite.app, suite.ctx, 2, sdk.NewInt(10000))
+}
+
+func (suite *KeeperTestSuite) TestDisableEnableMsgTypes() {
+	ctx, k := suite.ctx, suite.keeper
+	send := bank.NewMsgSend(suite.addrs[0], suite.addrs[1], sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))
+	delegate := staking.NewMsgDelegate(suite.addrs[0], sdk.ValAddress(suite.addrs[1]), sdk.NewInt64Coin("stake", 1))
+	undelegate := staking.NewMsgUndelegate(suite.addrs[0], sdk.ValAddress(suite.addrs[1]), sdk.NewInt64Coin("stake", 1))
+
+	suite.Require().True(k.IsAllowed(ctx, send))
+	suite.Require().Empty(k.GetDisabledMsgTypes(ctx))
+
+	// disable a single message type
+	suite.Require().NoError(k.DisableMsgType(ctx, types.MsgTypeOf(send)))
+	suite.Require().False(k.IsAllowed(ctx, send))
+	suite.Require().True(k.IsAllowed(ctx, delegate))
+
+	// disabling a route keeps its always allowed message types enabled
+	suite.Require().NoError(k.DisableMsgType(ctx, staking.RouterKey))
+	suite.Require().False(k.IsAllowed(ctx, undelegate))
+	suite.Require().True(k.IsAllowed(ctx, delegate))
+	suite.Require().Equal([]string{"bank/send", "staking"}, k.GetDisabledMsgTypes(ctx))
+
+	// enabling the route only enables the message types it covers
+	suite.Require().NoError(k.EnableMsgType(ctx, staking.RouterKey))
+	suite.Require().True(k.IsAllowed(ctx, undelegate))
+	suite.Require().False(k.IsAllowed(ctx, send))
+
+	suite.Require().NoError(k.EnableMsgType(ctx, types.MsgTypeOf(send)))
+	suite.Require().True(k.IsAllowed(ctx, send))
+	suite.Require().Empty(k.GetDisabledMsgTypes(ctx))
+
+	// enabling a message type that is not disabled is a no-op
+	suite.Require().NoError(k.EnableMsgType(ctx, gov.RouterKey))
+	suite.Require().Error(k.EnableMsgType(ctx, "bank/"))
+}
+
+func (suite *KeeperTestSuite) TestDisableAlwaysAllowed() {
+	ctx, k := suite.ctx, suite.keeper
+
+	suite.Require().True(types.ErrAlwaysAllowed.Is(k.DisableMsgType(ctx, "staking/delegate")))
+	suite.Require().True(types.ErrAlwaysAllowed.Is(k.DisableMsgType(ctx, types.RouterKey)))
+	suite.Require().True(types.ErrAlwaysAllowed.Is(k.DisableMsgType(ctx, types.RouterKey+"/"+types.TypeMsgEnableMsgTypes)))
+	suite.Require().True(types.ErrInvalidMsgType.Is(k.DisableMsgType(ctx, "")))
+	suite.Require().Empty(k.GetDisabledMsgTypes(ctx))
+
+	suite.Require().Panics(func() {
+		keeper.NewKeeper(
+			suite.app.Codec(), suite.app.GetKey(types.StoreKey), suite.app.GetSubspace(types.ModuleName), "bank/",
+		)
+	})
+}
+
+func (suite *KeeperTestSuite) TestParams() {
+	ctx, k := suite.ctx, suite.keeper
+
+	suite.Require().Empty(k.GetParams(ctx).Authorities)
+	suite.Require().False(k.IsAuthority(ctx, suite.addrs[0]))
+
+	k.SetParams(ctx, types.NewParams([]sdk.AccAddress{suite.addrs[0]}))
+	suite.Require().True(k.IsAuthority(ctx, suite.addrs[0]))
+	suite.Require().False(k.IsAuthority(ctx, suite.addrs[1]))
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit/internal/types"
+)
+
+// GetParams returns the total set of circuit parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the circuit parameters to the param space.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// IsAuthority returns whether the given address may disable and enable
+// message types.
+func (k Keeper) IsAuthority(ctx sdk.Context, addr sdk.AccAddress) bool {
+	return k.GetParams(ctx).IsAuthority(addr)
+}
@@ -0,0 +1,22 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// ModuleCdc defines the circuit module's codec
+var ModuleCdc = codec.New()
+
+// RegisterCodec registers all the necessary types and interfaces for the
+// circuit module.
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgDisableMsgTypes{}, "cosmos-sdk/MsgDisableMsgTypes", nil)
+	cdc.RegisterConcrete(MsgEnableMsgTypes{}, "cosmos-sdk/MsgEnableMsgTypes", nil)
+	cdc.RegisterConcrete(CircuitBreakerProposal{}, "cosmos-sdk/CircuitBreakerProposal", nil)
+}
+
+func init() {
+	RegisterCodec(ModuleCdc)
+	codec.RegisterCrypto(ModuleCdc)
+	ModuleCdc.Seal()
+}
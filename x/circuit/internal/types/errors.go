@@ -0,0 +1,12 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/circuit module sentinel errors
+var (
+	ErrUnauthorized   = sdkerrors.Register(ModuleName, 1, "account is not a circuit breaker authority")
+	ErrAlwaysAllowed  = sdkerrors.Register(ModuleName, 2, "message type cannot be disabled")
+	ErrInvalidMsgType = sdkerrors.Register(ModuleName, 3, "invalid message type")
+)
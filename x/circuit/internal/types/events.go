@@ -0,0 +1,11 @@
+package types
+
+// circuit module events
+const (
+	EventTypeDisableMsgType = "disable_msg_type"
+	EventTypeEnableMsgType  = "enable_msg_type"
+
+	AttributeKeyMsgType = "msg_type"
+
+	AttributeValueCategory = ModuleName
+)
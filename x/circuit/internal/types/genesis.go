@@ -0,0 +1,29 @@
+package types
+
+// GenesisState defines the circuit module's genesis state.
+type GenesisState struct {
+	Params           Params   `json:"params" yaml:"params"`
+	DisabledMsgTypes []string `json:"disabled_msg_types" yaml:"disabled_msg_types"`
+}
+
+func NewGenesisState(params Params, disabledMsgTypes []string) GenesisState {
+	return GenesisState{
+		Params:           params,
+		DisabledMsgTypes: disabledMsgTypes,
+	}
+}
+
+// DefaultGenesisState returns the circuit module's default genesis state.
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState(DefaultParams(), []string{})
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	return ValidateMsgTypes(gs.DisabledMsgTypes)
+}
@@ -0,0 +1,29 @@
+package types
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = "circuit"
+
+	// StoreKey is the store key string for circuit
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for circuit
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for circuit
+	QuerierRoute = ModuleName
+
+	// DefaultParamspace defines the default circuit module parameter subspace
+	DefaultParamspace = ModuleName
+)
+
+// KVStore key prefixes
+var (
+	// DisabledMsgTypeKeyPrefix is the prefix of the disabled message types
+	DisabledMsgTypeKeyPrefix = []byte{0x00}
+)
+
+// DisabledMsgTypeKey is the key under which a disabled message type is stored.
+func DisabledMsgTypeKey(msgType string) []byte {
+	return append(DisabledMsgTypeKeyPrefix, []byte(msgType)...)
+}
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MsgTypeOf returns the identifier of the given message's type, i.e.
+// "<route>/<type>".
+func MsgTypeOf(msg sdk.Msg) string {
+	return fmt.Sprintf("%s/%s", msg.Route(), msg.Type())
+}
+
+// ValidateMsgType validates a message type identifier, which is either a
+// message route, e.g. "bank", covering all messages of the route, or a single
+// message type, e.g. "bank/send".
+func ValidateMsgType(msgType string) error {
+	parts := strings.Split(msgType, "/")
+	if len(parts) > 2 {
+		return fmt.Errorf("invalid message type %q; must be <route> or <route>/<type>", msgType)
+	}
+
+	if !sdk.IsAlphaNumeric(parts[0]) {
+		return fmt.Errorf("invalid message route %q; must be alphanumeric", parts[0])
+	}
+
+	if len(parts) == 2 && strings.TrimSpace(parts[1]) == "" {
+		return fmt.Errorf("invalid message type %q; type cannot be blank", msgType)
+	}
+
+	return nil
+}
+
+// ValidateMsgTypes validates a list of unique message type identifiers. An
+// empty list is valid; callers requiring at least one message type check it
+// themselves.
+func ValidateMsgTypes(msgTypes []string) error {
+	seen := make(map[string]bool, len(msgTypes))
+	for _, msgType := range msgTypes {
+		if err := ValidateMsgType(msgType); err != nil {
+			return err
+		}
+
+		if seen[msgType] {
+			return fmt.Errorf("duplicate message type %s", msgType)
+		}
+		seen[msgType] = true
+	}
+
+	return nil
+}
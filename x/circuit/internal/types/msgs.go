@@ -0,0 +1,108 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Message types for the circuit module
+const (
+	TypeMsgDisableMsgTypes = "disable_msg_types"
+	TypeMsgEnableMsgTypes  = "enable_msg_types"
+)
+
+var (
+	_ sdk.Msg = MsgDisableMsgTypes{}
+	_ sdk.Msg = MsgEnableMsgTypes{}
+)
+
+// MsgDisableMsgTypes stops the dispatch of the given message types, each
+// either a message route or a single "<route>/<type>". It must be signed by a
+// circuit breaker authority.
+type MsgDisableMsgTypes struct {
+	Authority sdk.AccAddress `json:"authority" yaml:"authority"`
+	MsgTypes  []string       `json:"msg_types" yaml:"msg_types"`
+}
+
+func NewMsgDisableMsgTypes(authority sdk.AccAddress, msgTypes []string) MsgDisableMsgTypes {
+	return MsgDisableMsgTypes{
+		Authority: authority,
+		MsgTypes:  msgTypes,
+	}
+}
+
+// Route returns the MsgDisableMsgTypes's route.
+func (msg MsgDisableMsgTypes) Route() string { return RouterKey }
+
+// Type returns the MsgDisableMsgTypes's type.
+func (msg MsgDisableMsgTypes) Type() string { return TypeMsgDisableMsgTypes }
+
+// ValidateBasic performs basic (non-state-dependant) validation on a
+// MsgDisableMsgTypes.
+func (msg MsgDisableMsgTypes) ValidateBasic() error {
+	return validateMsgTypesMsg(msg.Authority, msg.MsgTypes)
+}
+
+// GetSignBytes returns the raw bytes a signer is expected to sign when
+// disabling message types.
+func (msg MsgDisableMsgTypes) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners returns the authority as the single expected signer.
+func (msg MsgDisableMsgTypes) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Authority}
+}
+
+// MsgEnableMsgTypes resumes the dispatch of the given, previously disabled,
+// message types. It must be signed by a circuit breaker authority.
+type MsgEnableMsgTypes struct {
+	Authority sdk.AccAddress `json:"authority" yaml:"authority"`
+	MsgTypes  []string       `json:"msg_types" yaml:"msg_types"`
+}
+
+func NewMsgEnableMsgTypes(authority sdk.AccAddress, msgTypes []string) MsgEnableMsgTypes {
+	return MsgEnableMsgTypes{
+		Authority: authority,
+		MsgTypes:  msgTypes,
+	}
+}
+
+// Route returns the MsgEnableMsgTypes's route.
+func (msg MsgEnableMsgTypes) Route() string { return RouterKey }
+
+// Type returns the MsgEnableMsgTypes's type.
+func (msg MsgEnableMsgTypes) Type() string { return TypeMsgEnableMsgTypes }
+
+// ValidateBasic performs basic (non-state-dependant) validation on a
+// MsgEnableMsgTypes.
+func (msg MsgEnableMsgTypes) ValidateBasic() error {
+	return validateMsgTypesMsg(msg.Authority, msg.MsgTypes)
+}
+
+// GetSignBytes returns the raw bytes a signer is expected to sign when
+// enabling message types.
+func (msg MsgEnableMsgTypes) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners returns the authority as the single expected signer.
+func (msg MsgEnableMsgTypes) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Authority}
+}
+
+func validateMsgTypesMsg(authority sdk.AccAddress, msgTypes []string) error {
+	if authority.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing authority address")
+	}
+
+	if len(msgTypes) == 0 {
+		return sdkerrors.Wrap(ErrInvalidMsgType, "no message types given")
+	}
+
+	if err := ValidateMsgTypes(msgTypes); err != nil {
+		return sdkerrors.Wrap(ErrInvalidMsgType, err.Error())
+	}
+
+	return nil
+}
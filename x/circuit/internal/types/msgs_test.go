@@ -0,0 +1,82 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit/internal/types"
+)
+
+func TestValidateMsgType(t *testing.T) {
+	require.NoError(t, types.ValidateMsgType("bank"))
+	require.NoError(t, types.ValidateMsgType("bank/send"))
+	require.Error(t, types.ValidateMsgType(""))
+	require.Error(t, types.ValidateMsgType("bank/"))
+	require.Error(t, types.ValidateMsgType("/send"))
+	require.Error(t, types.ValidateMsgType("bank/send/all"))
+	require.Error(t, types.ValidateMsgType("bank-v2/send"))
+
+	require.NoError(t, types.ValidateMsgTypes(nil))
+	require.NoError(t, types.ValidateMsgTypes([]string{"bank", "bank/send"}))
+	require.Error(t, types.ValidateMsgTypes([]string{"bank/send", "bank/send"}))
+}
+
+func TestMsgDisableMsgTypes(t *testing.T) {
+	authority := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	testCases := map[string]struct {
+		msg   types.MsgDisableMsgTypes
+		valid bool
+	}{
+		"valid":             {types.NewMsgDisableMsgTypes(authority, []string{"bank", "staking/delegate"}), true},
+		"missing authority": {types.NewMsgDisableMsgTypes(nil, []string{"bank"}), false},
+		"no msg types":      {types.NewMsgDisableMsgTypes(authority, nil), false},
+		"invalid msg type":  {types.NewMsgDisableMsgTypes(authority, []string{"bank/"}), false},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, []sdk.AccAddress{authority}, tc.msg.GetSigners())
+		})
+	}
+}
+
+func TestMsgEnableMsgTypes(t *testing.T) {
+	authority := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	require.NoError(t, types.NewMsgEnableMsgTypes(authority, []string{"bank/send"}).ValidateBasic())
+	require.Error(t, types.NewMsgEnableMsgTypes(nil, []string{"bank/send"}).ValidateBasic())
+	require.Error(t, types.NewMsgEnableMsgTypes(authority, []string{}).ValidateBasic())
+	require.Error(t, types.NewMsgEnableMsgTypes(authority, []string{"bank/send", "bank/send"}).ValidateBasic())
+}
+
+func TestCircuitBreakerProposal(t *testing.T) {
+	require.NoError(t, types.NewCircuitBreakerProposal("title", "description", []string{"bank"}, nil).ValidateBasic())
+	require.NoError(t, types.NewCircuitBreakerProposal("title", "description", nil, []string{"bank"}).ValidateBasic())
+	require.Error(t, types.NewCircuitBreakerProposal("", "description", []string{"bank"}, nil).ValidateBasic())
+	require.Error(t, types.NewCircuitBreakerProposal("title", "description", nil, nil).ValidateBasic())
+	require.Error(t, types.NewCircuitBreakerProposal("title", "description", []string{"bank"}, []string{"bank"}).ValidateBasic())
+}
+
+func TestParamsValidate(t *testing.T) {
+	authority := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	require.NoError(t, types.DefaultParams().Validate())
+	require.NoError(t, types.NewParams([]sdk.AccAddress{authority}).Validate())
+	require.Error(t, types.NewParams([]sdk.AccAddress{authority, authority}).Validate())
+	require.Error(t, types.NewParams([]sdk.AccAddress{{}}).Validate())
+
+	require.True(t, types.NewParams([]sdk.AccAddress{authority}).IsAuthority(authority))
+	require.False(t, types.DefaultParams().IsAuthority(authority))
+}
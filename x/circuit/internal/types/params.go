@@ -0,0 +1,94 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+var (
+	// KeyAuthorities is the store key of the accounts allowed to disable and
+	// enable message types
+	KeyAuthorities = []byte("Authorities")
+)
+
+var _ params.ParamSet = (*Params)(nil)
+
+// Params defines the parameters of the circuit module.
+type Params struct {
+	Authorities []sdk.AccAddress `json:"authorities" yaml:"authorities"`
+}
+
+// NewParams creates a new Params object
+func NewParams(authorities []sdk.AccAddress) Params {
+	return Params{
+		Authorities: authorities,
+	}
+}
+
+// DefaultParams returns the default circuit module parameters, which have no
+// authorities so that only governance can disable message types.
+func DefaultParams() Params {
+	return NewParams([]sdk.AccAddress{})
+}
+
+// ParamKeyTable returns the parameter key table of the circuit module.
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// ParamSetPairs implements the ParamSet interface and returns all the key/value
+// pairs of the circuit module's parameters.
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		params.NewParamSetPair(KeyAuthorities, &p.Authorities, validateAuthorities),
+	}
+}
+
+// IsAuthority returns whether the given address is a circuit breaker
+// authority.
+func (p Params) IsAuthority(addr sdk.AccAddress) bool {
+	for _, authority := range p.Authorities {
+		if authority.Equals(addr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// String implements the stringer interface for Params.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return strings.TrimSpace(string(out))
+}
+
+// Validate performs basic validation on the circuit module parameters.
+func (p Params) Validate() error {
+	return validateAuthorities(p.Authorities)
+}
+
+func validateAuthorities(i interface{}) error {
+	v, ok := i.([]sdk.AccAddress)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(v))
+	for _, authority := range v {
+		if authority.Empty() {
+			return fmt.Errorf("authority address cannot be empty")
+		}
+
+		if seen[authority.String()] {
+			return fmt.Errorf("duplicate authority %s", authority)
+		}
+		seen[authority.String()] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+)
+
+const (
+	// ProposalTypeCircuitBreaker defines the type for a CircuitBreakerProposal
+	ProposalTypeCircuitBreaker = "CircuitBreaker"
+)
+
+// Assert CircuitBreakerProposal implements gov.Content at compile-time
+var _ gov.Content = CircuitBreakerProposal{}
+
+func init() {
+	gov.RegisterProposalType(ProposalTypeCircuitBreaker)
+	gov.RegisterProposalTypeCodec(CircuitBreakerProposal{}, "cosmos-sdk/CircuitBreakerProposal")
+}
+
+// CircuitBreakerProposal disables and enables message types through
+// governance.
+type CircuitBreakerProposal struct {
+	Title           string   `json:"title" yaml:"title"`
+	Description     string   `json:"description" yaml:"description"`
+	DisableMsgTypes []string `json:"disable_msg_types" yaml:"disable_msg_types"`
+	EnableMsgTypes  []string `json:"enable_msg_types" yaml:"enable_msg_types"`
+}
+
+// NewCircuitBreakerProposal creates a new circuit breaker proposal.
+func NewCircuitBreakerProposal(title, description string, disableMsgTypes, enableMsgTypes []string) gov.Content {
+	return CircuitBreakerProposal{title, description, disableMsgTypes, enableMsgTypes}
+}
+
+// GetTitle returns the title of a circuit breaker proposal.
+func (cbp CircuitBreakerProposal) GetTitle() string { return cbp.Title }
+
+// GetDescription returns the description of a circuit breaker proposal.
+func (cbp CircuitBreakerProposal) GetDescription() string { return cbp.Description }
+
+// ProposalRoute returns the routing key of a circuit breaker proposal.
+func (cbp CircuitBreakerProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a circuit breaker proposal.
+func (cbp CircuitBreakerProposal) ProposalType() string { return ProposalTypeCircuitBreaker }
+
+// ValidateBasic runs basic stateless validity checks
+func (cbp CircuitBreakerProposal) ValidateBasic() error {
+	if err := gov.ValidateAbstract(cbp); err != nil {
+		return err
+	}
+
+	if len(cbp.DisableMsgTypes) == 0 && len(cbp.EnableMsgTypes) == 0 {
+		return sdkerrors.Wrap(ErrInvalidMsgType, "no message types given")
+	}
+
+	if err := ValidateMsgTypes(append(append([]string{}, cbp.DisableMsgTypes...), cbp.EnableMsgTypes...)); err != nil {
+		return sdkerrors.Wrap(ErrInvalidMsgType, err.Error())
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (cbp CircuitBreakerProposal) String() string {
+	return fmt.Sprintf(`Circuit Breaker Proposal:
+  Title:             %s
+  Description:       %s
+  Disable Msg Types: %s
+  Enable Msg Types:  %s
+`, cbp.Title, cbp.Description, strings.Join(cbp.DisableMsgTypes, ", "), strings.Join(cbp.EnableMsgTypes, ", "))
+}
@@ -0,0 +1,7 @@
+package types
+
+// Querier routes for the circuit module
+const (
+	QueryParameters       = "parameters"
+	QueryDisabledMsgTypes = "disabled_msg_types"
+)
@@ -80,6 +80,7 @@ var (
 	ValidateGenesis                            = types.ValidateGenesis
 	NewMsgSetWithdrawAddress                   = types.NewMsgSetWithdrawAddress
 	NewMsgWithdrawDelegatorReward              = types.NewMsgWithdrawDelegatorReward
+	NewMsgWithdrawAllDelegatorRewards          = types.NewMsgWithdrawAllDelegatorRewards
 	NewMsgWithdrawValidatorCommission          = types.NewMsgWithdrawValidatorCommission
 	MsgFundCommunityPool                       = types.NewMsgFundCommunityPool
 	NewCommunityPoolSpendProposal              = types.NewCommunityPoolSpendProposal
@@ -139,6 +140,7 @@ type (
 	GenesisState                           = types.GenesisState
 	MsgSetWithdrawAddress                  = types.MsgSetWithdrawAddress
 	MsgWithdrawDelegatorReward             = types.MsgWithdrawDelegatorReward
+	MsgWithdrawAllDelegatorRewards         = types.MsgWithdrawAllDelegatorRewards
 	MsgWithdrawValidatorCommission         = types.MsgWithdrawValidatorCommission
 	CommunityPoolSpendProposal             = types.CommunityPoolSpendProposal
 	QueryValidatorOutstandingRewardsParams = types.QueryValidatorOutstandingRewardsParams
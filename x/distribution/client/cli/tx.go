@@ -19,7 +19,6 @@ import (
 	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
 	"github.com/cosmos/cosmos-sdk/x/gov"
 
-	"github.com/cosmos/cosmos-sdk/x/distribution/client/common"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
 
@@ -27,11 +26,6 @@ var (
 	flagOnlyFromValidator = "only-from-validator"
 	flagIsValidator       = "is-validator"
 	flagCommission        = "commission"
-	flagMaxMessagesPerTx  = "max-msgs"
-)
-
-const (
-	MaxMessagesPerTxDefault = 5
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -47,45 +41,13 @@ func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 	distTxCmd.AddCommand(flags.PostCommands(
 		GetCmdWithdrawRewards(cdc),
 		GetCmdSetWithdrawAddr(cdc),
-		GetCmdWithdrawAllRewards(cdc, storeKey),
+		GetCmdWithdrawAllRewards(cdc),
 		GetCmdFundCommunityPool(cdc),
 	)...)
 
 	return distTxCmd
 }
 
-type generateOrBroadcastFunc func(context.CLIContext, auth.TxBuilder, []sdk.Msg) error
-
-func splitAndApply(
-	generateOrBroadcast generateOrBroadcastFunc,
-	cliCtx context.CLIContext,
-	txBldr auth.TxBuilder,
-	msgs []sdk.Msg,
-	chunkSize int,
-) error {
-
-	if chunkSize == 0 {
-		return generateOrBroadcast(cliCtx, txBldr, msgs)
-	}
-
-	// split messages into slices of length chunkSize
-	totalMessages := len(msgs)
-	for i := 0; i < len(msgs); i += chunkSize {
-
-		sliceEnd := i + chunkSize
-		if sliceEnd > totalMessages {
-			sliceEnd = totalMessages
-		}
-
-		msgChunk := msgs[i:sliceEnd]
-		if err := generateOrBroadcast(cliCtx, txBldr, msgChunk); err != nil {
-			return err
-		}
-	}
-
-	return nil
-}
-
 // command to withdraw rewards
 func GetCmdWithdrawRewards(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -127,12 +89,12 @@ $ %s tx distribution withdraw-rewards cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fx
 }
 
 // command to withdraw all rewards
-func GetCmdWithdrawAllRewards(cdc *codec.Codec, queryRoute string) *cobra.Command {
-	cmd := &cobra.Command{
+func GetCmdWithdrawAllRewards(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
 		Use:   "withdraw-all-rewards",
 		Short: "withdraw all delegations rewards for a delegator",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Withdraw all rewards for a single delegator.
+			fmt.Sprintf(`Withdraw all rewards for a single delegator in a single message.
 
 Example:
 $ %s tx distribution withdraw-all-rewards --from mykey
@@ -146,26 +108,14 @@ $ %s tx distribution withdraw-all-rewards --from mykey
 			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
 			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
 
-			delAddr := cliCtx.GetFromAddress()
-
-			// The transaction cannot be generated offline since it requires a query
-			// to get all the validators.
-			if cliCtx.GenerateOnly {
-				return fmt.Errorf("command disabled with the provided flag: %s", flags.FlagGenerateOnly)
-			}
-
-			msgs, err := common.WithdrawAllDelegatorRewards(cliCtx, queryRoute, delAddr)
-			if err != nil {
+			msg := types.NewMsgWithdrawAllDelegatorRewards(cliCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
 
-			chunkSize := viper.GetInt(flagMaxMessagesPerTx)
-			return splitAndApply(authclient.GenerateOrBroadcastMsgs, cliCtx, txBldr, msgs, chunkSize)
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
-
-	cmd.Flags().Int(flagMaxMessagesPerTx, MaxMessagesPerTxDefault, "Limit the number of messages per tx (0 for unlimited)")
-	return cmd
 }
 
 // command to replace a delegator's withdrawal address
@@ -50,34 +50,6 @@ func QueryValidatorCommission(cliCtx context.CLIContext, queryRoute string, vali
 	return res, err
 }
 
-// WithdrawAllDelegatorRewards builds a multi-message slice to be used
-// to withdraw all delegations rewards for the given delegator.
-func WithdrawAllDelegatorRewards(cliCtx context.CLIContext, queryRoute string, delegatorAddr sdk.AccAddress) ([]sdk.Msg, error) {
-	// retrieve the comprehensive list of all validators which the
-	// delegator had submitted delegations to
-	bz, err := QueryDelegatorValidators(cliCtx, queryRoute, delegatorAddr)
-	if err != nil {
-		return nil, err
-	}
-
-	var validators []sdk.ValAddress
-	if err := cliCtx.Codec.UnmarshalJSON(bz, &validators); err != nil {
-		return nil, err
-	}
-
-	// build multi-message transaction
-	msgs := make([]sdk.Msg, 0, len(validators))
-	for _, valAddr := range validators {
-		msg := types.NewMsgWithdrawDelegatorReward(delegatorAddr, valAddr)
-		if err := msg.ValidateBasic(); err != nil {
-			return nil, err
-		}
-		msgs = append(msgs, msg)
-	}
-
-	return msgs, nil
-}
-
 // WithdrawValidatorRewardsAndCommission builds a two-message message slice to be
 // used to withdraw both validation's commission and self-delegation reward.
 func WithdrawValidatorRewardsAndCommission(validatorAddr sdk.ValAddress) ([]sdk.Msg, error) {
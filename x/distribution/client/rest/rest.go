@@ -17,7 +17,7 @@ import (
 // RegisterRoutes register distribution REST routes.
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, queryRoute string) {
 	registerQueryRoutes(cliCtx, r, queryRoute)
-	registerTxRoutes(cliCtx, r, queryRoute)
+	registerTxRoutes(cliCtx, r)
 }
 
 // ProposalRESTHandler returns a ProposalRESTHandler that exposes the community pool spend REST handler with a given sub-route.
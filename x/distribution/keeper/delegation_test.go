@@ -352,6 +352,76 @@ func TestWithdrawDelegationRewardsBasic(t *testing.T) {
 	)
 }
 
+func TestWithdrawAllDelegationRewards(t *testing.T) {
+	balancePower := int64(1000)
+	balanceTokens := sdk.TokensFromConsensusPower(balancePower)
+	ctx, _, bk, k, sk, _ := CreateTestInputDefault(t, false, balancePower)
+	sh := staking.NewHandler(sk)
+
+	// set module account coins
+	distrAcc := k.GetDistributionAccount(ctx)
+	require.NoError(t, bk.SetBalances(ctx, distrAcc.GetAddress(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, balanceTokens))))
+	k.supplyKeeper.SetModuleAccount(ctx, distrAcc)
+
+	// no delegations to withdraw from
+	_, err := k.WithdrawAllDelegationRewards(ctx, sdk.AccAddress(valOpAddr1))
+	require.Error(t, err)
+
+	// create two validators with no commission
+	valTokens := sdk.TokensFromConsensusPower(100)
+	commission := staking.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
+	msg := staking.NewMsgCreateValidator(
+		valOpAddr1, valConsPk1,
+		sdk.NewCoin(sdk.DefaultBondDenom, valTokens),
+		staking.Description{}, commission, sdk.OneInt(),
+	)
+	res, err := sh(ctx, msg)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	msg = staking.NewMsgCreateValidator(
+		valOpAddr2, valConsPk2,
+		sdk.NewCoin(sdk.DefaultBondDenom, valTokens),
+		staking.Description{}, commission, sdk.OneInt(),
+	)
+	res, err = sh(ctx, msg)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	// delegate from the first validator's operator to the second validator
+	delTokens := sdk.TokensFromConsensusPower(100)
+	msgDelegate := staking.NewMsgDelegate(sdk.AccAddress(valOpAddr1), valOpAddr2, sdk.NewCoin(sdk.DefaultBondDenom, delTokens))
+	res, err = sh(ctx, msgDelegate)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	// end block to bond validators
+	staking.EndBlocker(ctx, sk)
+
+	// next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// allocate rewards to both validators
+	initial := sdk.TokensFromConsensusPower(10)
+	tokens := sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)}
+	k.AllocateTokensToValidator(ctx, sk.Validator(ctx, valOpAddr1), tokens)
+	k.AllocateTokensToValidator(ctx, sk.Validator(ctx, valOpAddr2), tokens)
+
+	// withdraw from both delegations at once
+	rewards, err := k.WithdrawAllDelegationRewards(ctx, sdk.AccAddress(valOpAddr1))
+	require.NoError(t, err)
+
+	// all rewards of the first validator and half of the second validator's
+	expRewards := initial.Add(initial.QuoRaw(2))
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, expRewards)), rewards)
+
+	exp := balanceTokens.Sub(valTokens).Sub(delTokens).Add(expRewards)
+	require.Equal(t,
+		sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, exp)},
+		bk.GetAllBalances(ctx, sdk.AccAddress(valOpAddr1)),
+	)
+}
+
 func TestCalculateRewardsAfterManySlashesInSameBlock(t *testing.T) {
 	ctx, _, _, k, sk, _ := CreateTestInputDefault(t, false, 1000)
 	sh := staking.NewHandler(sk)
@@ -8,6 +8,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/staking/exported"
 
 	"github.com/tendermint/tendermint/libs/log"
 )
@@ -112,6 +113,35 @@ func (k Keeper) WithdrawDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddres
 	return rewards, nil
 }
 
+// withdraw rewards from every delegation of a delegator
+func (k Keeper) WithdrawAllDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress) (sdk.Coins, error) {
+	// collect the validators first, as withdrawing updates delegation state
+	var valAddrs []sdk.ValAddress
+	k.stakingKeeper.IterateDelegations(
+		ctx, delAddr,
+		func(_ int64, del exported.DelegationI) (stop bool) {
+			valAddrs = append(valAddrs, del.GetValidatorAddr())
+			return false
+		},
+	)
+
+	if len(valAddrs) == 0 {
+		return nil, types.ErrEmptyDelegationDistInfo
+	}
+
+	rewards := sdk.NewCoins()
+	for _, valAddr := range valAddrs {
+		coins, err := k.WithdrawDelegationRewards(ctx, delAddr, valAddr)
+		if err != nil {
+			return nil, err
+		}
+
+		rewards = rewards.Add(coins...)
+	}
+
+	return rewards, nil
+}
+
 // withdraw validator commission
 func (k Keeper) WithdrawValidatorCommission(ctx sdk.Context, valAddr sdk.ValAddress) (sdk.Coins, error) {
 	// fetch validator accumulated commission
@@ -4,14 +4,16 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing"
 
 	"github.com/cosmos/cosmos-sdk/x/evidence/internal/types"
 )
 
 // HandleDoubleSign implements an equivocation evidence handler. Assuming the
-// evidence is valid, the validator committing the misbehavior will be slashed,
-// jailed and tombstoned. Once tombstoned, the validator will not be able to
-// recover. Note, the evidence contains the block time and height at the time of
+// evidence is valid, the validator committing the misbehavior will be punished
+// as defined by the x/slashing double sign infraction parameters, i.e. by
+// default slashed, jailed and tombstoned. Once tombstoned, the validator will
+// not be able to recover. Note, the evidence contains the block time and height at the time of
 // the equivocation.
 //
 // The evidence is considered invalid if:
@@ -87,23 +89,17 @@ func (k Keeper) HandleDoubleSign(ctx sdk.Context, evidence types.Equivocation) {
 	// That's fine since this is just used to filter unbonding delegations & redelegations.
 	distributionHeight := infractionHeight - sdk.ValidatorUpdateDelay
 
-	// Slash validator. The `power` is the int64 power of the validator as provided
-	// to/by Tendermint. This value is validator.Tokens as sent to Tendermint via
-	// ABCI, and now received as evidence. The fraction is passed in to separately
-	// to slash unbonding and rebonding delegations.
-	k.slashingKeeper.Slash(
+	// Slash, jail and tombstone the validator as defined by the x/slashing double
+	// sign infraction parameters. The `power` is the int64 power of the validator
+	// as provided to/by Tendermint. This value is validator.Tokens as sent to
+	// Tendermint via ABCI, and now received as evidence.
+	err := k.slashingKeeper.HandleInfraction(
 		ctx,
 		consAddr,
-		k.slashingKeeper.SlashFractionDoubleSign(ctx),
+		slashing.InfractionDoubleSign,
 		evidence.GetValidatorPower(), distributionHeight,
 	)
-
-	// Jail the validator if not already jailed. This will begin unbonding the
-	// validator if not already unbonding (tombstoned).
-	if !validator.IsJailed() {
-		k.slashingKeeper.Jail(ctx, consAddr)
+	if err != nil {
+		panic(fmt.Sprintf("failed to punish double sign from %s: %s", consAddr, err))
 	}
-
-	k.slashingKeeper.JailUntil(ctx, consAddr, types.DoubleSignJailEndTime)
-	k.slashingKeeper.Tombstone(ctx, consAddr)
 }
@@ -1,8 +1,6 @@
 package types
 
 import (
-	"time"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingexported "github.com/cosmos/cosmos-sdk/x/staking/exported"
 
@@ -22,10 +20,6 @@ type (
 		GetPubkey(sdk.Context, crypto.Address) (crypto.PubKey, error)
 		IsTombstoned(sdk.Context, sdk.ConsAddress) bool
 		HasValidatorSigningInfo(sdk.Context, sdk.ConsAddress) bool
-		Tombstone(sdk.Context, sdk.ConsAddress)
-		Slash(sdk.Context, sdk.ConsAddress, sdk.Dec, int64, int64)
-		SlashFractionDoubleSign(sdk.Context) sdk.Dec
-		Jail(sdk.Context, sdk.ConsAddress)
-		JailUntil(sdk.Context, sdk.ConsAddress, time.Time)
+		HandleInfraction(ctx sdk.Context, consAddr sdk.ConsAddress, infraction string, power, distributionHeight int64) error
 	}
 )
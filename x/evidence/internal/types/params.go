@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/slashing"
 
 	"gopkg.in/yaml.v2"
 )
@@ -23,7 +24,10 @@ var (
 
 	// The Double Sign Jail period ends at Max Time supported by Amino
 	// (Dec 31, 9999 - 23:59:59 GMT).
-	DoubleSignJailEndTime = time.Unix(253402300799, 0)
+	//
+	// Deprecated: tombstoned validators are jailed until
+	// slashing.JailForeverTime, which this aliases.
+	DoubleSignJailEndTime = slashing.JailForeverTime
 )
 
 // Params defines the total set of parameters for the evidence module
@@ -0,0 +1,64 @@
+package feegrant
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/feegrant/internal/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/internal/types"
+)
+
+// nolint
+
+const (
+	ModuleName                = types.ModuleName
+	StoreKey                  = types.StoreKey
+	RouterKey                 = types.RouterKey
+	QuerierRoute              = types.QuerierRoute
+	QueryGetFeeAllowances     = types.QueryGetFeeAllowances
+	TypeMsgGrantFeeAllowance  = types.TypeMsgGrantFeeAllowance
+	TypeMsgRevokeFeeAllowance = types.TypeMsgRevokeFeeAllowance
+	EventTypeUseFeeGrant      = types.EventTypeUseFeeGrant
+	EventTypeRevokeFeeGrant   = types.EventTypeRevokeFeeGrant
+	EventTypeSetFeeGrant      = types.EventTypeSetFeeGrant
+	AttributeKeyGranter       = types.AttributeKeyGranter
+	AttributeKeyGrantee       = types.AttributeKeyGrantee
+	AttributeValueCategory    = types.AttributeValueCategory
+)
+
+var (
+	NewKeeper  = keeper.NewKeeper
+	NewQuerier = keeper.NewQuerier
+
+	RegisterCodec                 = types.RegisterCodec
+	RegisterFeeAllowanceTypeCodec = types.RegisterFeeAllowanceTypeCodec
+	ModuleCdc                     = types.ModuleCdc
+	ExpiresAtTime                 = types.ExpiresAtTime
+	ExpiresAtHeight               = types.ExpiresAtHeight
+	ClockDuration                 = types.ClockDuration
+	BlockDuration                 = types.BlockDuration
+	NewFeeAllowanceGrant          = types.NewFeeAllowanceGrant
+	NewMsgGrantFeeAllowance       = types.NewMsgGrantFeeAllowance
+	NewMsgRevokeFeeAllowance      = types.NewMsgRevokeFeeAllowance
+	NewQueryFeeAllowancesParams   = types.NewQueryFeeAllowancesParams
+	NewGenesisState               = types.NewGenesisState
+	DefaultGenesisState           = types.DefaultGenesisState
+	FeeAllowanceKey               = types.FeeAllowanceKey
+	FeeAllowancePrefixByGrantee   = types.FeeAllowancePrefixByGrantee
+
+	ErrFeeLimitExceeded = types.ErrFeeLimitExceeded
+	ErrFeeLimitExpired  = types.ErrFeeLimitExpired
+	ErrInvalidDuration  = types.ErrInvalidDuration
+	ErrNoAllowance      = types.ErrNoAllowance
+)
+
+type (
+	Keeper = keeper.Keeper
+
+	BasicFeeAllowance        = types.BasicFeeAllowance
+	PeriodicFeeAllowance     = types.PeriodicFeeAllowance
+	ExpiresAt                = types.ExpiresAt
+	Duration                 = types.Duration
+	FeeAllowanceGrant        = types.FeeAllowanceGrant
+	MsgGrantFeeAllowance     = types.MsgGrantFeeAllowance
+	MsgRevokeFeeAllowance    = types.MsgRevokeFeeAllowance
+	QueryFeeAllowancesParams = types.QueryFeeAllowancesParams
+	GenesisState             = types.GenesisState
+)
@@ -0,0 +1,26 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/internal/keeper"
+)
+
+// NewAnteHandler returns an AnteHandler that checks and increments sequence
+// numbers, checks signatures & account numbers, and deducts fees from the
+// first signer, or from the fee granter when the first signer has been granted
+// a fee allowance.
+func NewAnteHandler(
+	ak authkeeper.AccountKeeper, supplyKeeper authtypes.SupplyKeeper, feeGrantKeeper keeper.Keeper,
+	sigGasConsumer authante.SignatureVerificationGasConsumer,
+) sdk.AnteHandler {
+
+	return authante.NewAnteHandler(
+		ak, supplyKeeper, sigGasConsumer,
+		authante.ReplaceDecorator(
+			authante.DeductFeeDecorator{}, NewDeductGrantedFeeDecorator(ak, supplyKeeper, feeGrantKeeper),
+		),
+	)
+}
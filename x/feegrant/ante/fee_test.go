@@ -0,0 +1,116 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/ante"
+)
+
+func TestDeductGrantedFees(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Height: 1})
+	app.AccountKeeper.SetParams(ctx, authtypes.DefaultParams())
+
+	dfd := ante.NewDeductGrantedFeeDecorator(app.AccountKeeper, app.SupplyKeeper, app.FeeGrantKeeper)
+	antehandler := sdk.ChainAnteDecorators(dfd)
+
+	// keys and addresses
+	priv1, _, addr1 := authtypes.KeyTestPubAddr()
+	priv2, _, addr2 := authtypes.KeyTestPubAddr()
+	_, _, addr3 := authtypes.KeyTestPubAddr()
+
+	// the grantee holds no tokens while the granter is funded
+	granterCoins := sdk.NewCoins(sdk.NewInt64Coin("atom", 1000))
+	for _, addr := range []sdk.AccAddress{addr1, addr2, addr3} {
+		app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addr))
+	}
+	app.BankKeeper.SetBalances(ctx, addr2, granterCoins)
+	app.BankKeeper.SetBalances(ctx, addr3, granterCoins)
+
+	app.FeeGrantKeeper.GrantFeeAllowance(ctx, feegrant.NewFeeAllowanceGrant(addr2, addr1, &feegrant.BasicFeeAllowance{
+		SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 500)),
+	}))
+
+	newTx := func(priv crypto.PrivKey, signer sdk.AccAddress, granter sdk.AccAddress, feeAmt int64) sdk.Tx {
+		fee := authtypes.NewStdFee(100000, sdk.NewCoins(sdk.NewInt64Coin("atom", feeAmt)))
+		fee.Granter = granter
+
+		msgs := []sdk.Msg{authtypes.NewTestMsg(signer)}
+		return authtypes.NewTestTx(ctx, msgs, []crypto.PrivKey{priv}, []uint64{0}, []uint64{0}, fee)
+	}
+
+	// the cases run in order as each builds upon the balances left by the previous one
+	testCases := []struct {
+		name    string
+		tx      sdk.Tx
+		valid   bool
+		balance sdk.AccAddress
+		expBal  sdk.Coins
+	}{
+		{
+			name:    "paid by the fee payer without a granter",
+			tx:      newTx(priv2, addr2, nil, 100),
+			valid:   true,
+			balance: addr2,
+			expBal:  sdk.NewCoins(sdk.NewInt64Coin("atom", 900)),
+		},
+		{
+			name:    "paid by the granter",
+			tx:      newTx(priv1, addr1, addr2, 200),
+			valid:   true,
+			balance: addr2,
+			expBal:  sdk.NewCoins(sdk.NewInt64Coin("atom", 700)),
+		},
+		{
+			name:    "over the allowance",
+			tx:      newTx(priv1, addr1, addr2, 400),
+			valid:   false,
+			balance: addr2,
+			expBal:  sdk.NewCoins(sdk.NewInt64Coin("atom", 700)),
+		},
+		{
+			name:    "no allowance from granter",
+			tx:      newTx(priv1, addr1, addr3, 100),
+			valid:   false,
+			balance: addr3,
+			expBal:  granterCoins,
+		},
+		{
+			name:    "self granted fees are paid by the fee payer",
+			tx:      newTx(priv2, addr2, addr2, 100),
+			valid:   true,
+			balance: addr2,
+			expBal:  sdk.NewCoins(sdk.NewInt64Coin("atom", 600)),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			cacheCtx, write := ctx.CacheContext()
+
+			_, err := antehandler(cacheCtx, tc.tx, false)
+			if tc.valid {
+				require.NoError(t, err)
+				write()
+			} else {
+				require.Error(t, err)
+			}
+
+			require.Equal(t, tc.expBal, app.BankKeeper.GetAllBalances(ctx, tc.balance))
+		})
+	}
+
+	// the remaining allowance reflects the fees paid by the granter
+	allowance := app.FeeGrantKeeper.GetFeeAllowance(ctx, addr2, addr1)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 300)), allowance.(*feegrant.BasicFeeAllowance).SpendLimit)
+}
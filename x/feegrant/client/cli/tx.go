@@ -0,0 +1,176 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/exported"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/internal/types"
+)
+
+const (
+	flagExpiration  = "expiration"
+	flagPeriod      = "period"
+	flagPeriodLimit = "period-limit"
+)
+
+// GetTxCmd returns the transaction commands for the feegrant module.
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	feegrantTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Feegrant transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	feegrantTxCmd.AddCommand(flags.PostCommands(
+		GetCmdGrantFeeAllowance(cdc),
+		GetCmdRevokeFeeAllowance(cdc),
+	)...)
+
+	return feegrantTxCmd
+}
+
+// GetCmdGrantFeeAllowance returns the command to grant a fee allowance.
+func GetCmdGrantFeeAllowance(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant [grantee] [spend-limit]",
+		Short: "Grant an account an allowance to pay transaction fees from the signer's account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Grant an account an allowance to pay transaction fees from the signer's
+account, up to the given spend limit. The allowance may expire at a given time,
+and may additionally limit the amount spent per period, in which case the period
+spend limit is refilled every period.
+
+Example:
+$ %s tx %s grant cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p 1000stake --from mykey
+$ %s tx %s grant cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p 1000stake --period 24h --period-limit 100stake --from mykey
+`,
+				version.ClientName, types.ModuleName, version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			spendLimit, err := sdk.ParseCoins(args[1])
+			if err != nil {
+				return err
+			}
+
+			allowance, err := newFeeAllowance(
+				spendLimit, viper.GetString(flagExpiration),
+				viper.GetDuration(flagPeriod), viper.GetString(flagPeriodLimit),
+			)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgGrantFeeAllowance(cliCtx.GetFromAddress(), grantee, allowance)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagExpiration, "", "The RFC3339 time at which the allowance expires")
+	cmd.Flags().Duration(flagPeriod, 0, "The period after which the period spend limit is refilled (e.g. 24h)")
+	cmd.Flags().String(flagPeriodLimit, "", "The maximum amount of fees that can be spent per period")
+
+	return cmd
+}
+
+// GetCmdRevokeFeeAllowance returns the command to revoke a fee allowance.
+func GetCmdRevokeFeeAllowance(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "revoke [grantee]",
+		Short: "Revoke the fee allowance the signer granted to an account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Revoke the fee allowance the signer granted to an account.
+
+Example:
+$ %s tx %s revoke cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p --from mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeFeeAllowance(cliCtx.GetFromAddress(), grantee)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// newFeeAllowance returns a BasicFeeAllowance, or a PeriodicFeeAllowance when a
+// period is provided.
+func newFeeAllowance(
+	spendLimit sdk.Coins, expirationStr string, period time.Duration, periodLimitStr string,
+) (exported.FeeAllowance, error) {
+
+	basic := types.BasicFeeAllowance{SpendLimit: spendLimit}
+
+	if expirationStr != "" {
+		expiration, err := time.Parse(time.RFC3339, expirationStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid expiration time: %w", err)
+		}
+
+		basic.Expiration = types.ExpiresAtTime(expiration)
+	}
+
+	if period == 0 {
+		if periodLimitStr != "" {
+			return nil, fmt.Errorf("--%s requires --%s to be set", flagPeriodLimit, flagPeriod)
+		}
+
+		return &basic, nil
+	}
+
+	periodLimit, err := sdk.ParseCoins(periodLimitStr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.PeriodicFeeAllowance{
+		Basic:            basic,
+		Period:           types.ClockDuration(period),
+		PeriodSpendLimit: periodLimit,
+	}, nil
+}
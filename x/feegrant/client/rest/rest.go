@@ -0,0 +1,19 @@
+package rest
+
+import (
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+)
+
+// REST query and parameter values
+const (
+	RestParamGrantee = "grantee"
+
+	MethodGet = "GET"
+)
+
+// RegisterRoutes registers the REST service handlers of the feegrant module.
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	registerQueryRoutes(cliCtx, r)
+}
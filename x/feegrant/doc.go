@@ -0,0 +1,38 @@
+/*
+Package feegrant implements a Cosmos SDK module that allows an account, the
+granter, to pay the transaction fees of another account, the grantee, up to the
+limits of a fee allowance. This enables onboarding users who hold no fee tokens.
+
+All concrete allowance types must implement the FeeAllowance interface contract.
+The module provides a BasicFeeAllowance, which grants a one-time spend limit that
+may expire at a given block time or height, and a PeriodicFeeAllowance, which in
+addition refills a per-period spend limit every period.
+
+Allowances are created with MsgGrantFeeAllowance and removed either with
+MsgRevokeFeeAllowance or once they are used up or expired. A transaction uses an
+allowance by setting the granter in its fee (see the --fee-account flag), in
+which case the fees are deducted from the granter's account instead of the first
+signer's. This is enforced by the DeductGrantedFeeDecorator, which replaces the
+x/auth DeductFeeDecorator in the AnteHandler returned by ante.NewAnteHandler.
+
+A full setup of the feegrant module may look something as follows:
+
+	ModuleBasics = module.NewBasicManager(
+	  // ...,
+	  feegrant.AppModuleBasic{},
+	)
+
+	feeGrantKeeper := feegrant.NewKeeper(app.cdc, keys[feegrant.StoreKey])
+
+	app.mm = module.NewManager(
+	  // ...
+	  feegrant.NewAppModule(feeGrantKeeper),
+	)
+
+	app.SetAnteHandler(
+	  feegrantante.NewAnteHandler(
+	    app.AccountKeeper, app.SupplyKeeper, feeGrantKeeper, auth.DefaultSigVerificationGasConsumer,
+	  ),
+	)
+*/
+package feegrant
@@ -0,0 +1,25 @@
+package exported
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// FeeAllowance implementations are tied to a given granter and grantee, and are
+// used to enforce fee grant limits.
+type FeeAllowance interface {
+	// Accept uses the requested fee payment as well as the time and height of
+	// the current block to determine whether or not to pay the fee. If it
+	// returns an error, the fee payment is rejected, otherwise it is accepted
+	// and the allowance, which is expected to update its internal state, is
+	// saved again.
+	//
+	// If remove is true (regardless of the error), the allowance is deleted
+	// from the store, e.g. once it has been used up or has expired.
+	Accept(fee sdk.Coins, blockTime time.Time, blockHeight int64) (remove bool, err error)
+
+	// ValidateBasic should evaluate this FeeAllowance for internal consistency,
+	// e.g. rejecting negative amounts or periods.
+	ValidateBasic() error
+}
@@ -0,0 +1,50 @@
+package feegrant_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+)
+
+func TestImportExportGenesis(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Height: 1})
+
+	granter := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	grantee := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	coins := sdk.NewCoins(sdk.NewInt64Coin("atom", 1000))
+
+	grants := []feegrant.FeeAllowanceGrant{
+		feegrant.NewFeeAllowanceGrant(granter, grantee, &feegrant.BasicFeeAllowance{
+			SpendLimit: coins,
+			Expiration: feegrant.ExpiresAtHeight(100),
+		}),
+		feegrant.NewFeeAllowanceGrant(grantee, granter, &feegrant.PeriodicFeeAllowance{
+			Basic:            feegrant.BasicFeeAllowance{SpendLimit: coins},
+			Period:           feegrant.BlockDuration(10),
+			PeriodSpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 100)),
+		}),
+	}
+
+	genesis := feegrant.NewGenesisState(grants)
+	bz := feegrant.ModuleCdc.MustMarshalJSON(genesis)
+	require.NoError(t, feegrant.AppModuleBasic{}.ValidateGenesis(bz))
+
+	feegrant.InitGenesis(ctx, app.FeeGrantKeeper, genesis)
+
+	exported := feegrant.ExportGenesis(ctx, app.FeeGrantKeeper)
+	require.ElementsMatch(t, grants, exported.FeeAllowances)
+
+	// an invalid grant is rejected
+	invalid := feegrant.NewGenesisState([]feegrant.FeeAllowanceGrant{
+		feegrant.NewFeeAllowanceGrant(granter, granter, &feegrant.BasicFeeAllowance{SpendLimit: coins}),
+	})
+	require.Error(t, invalid.Validate())
+	require.Panics(t, func() { feegrant.InitGenesis(ctx, app.FeeGrantKeeper, invalid) })
+}
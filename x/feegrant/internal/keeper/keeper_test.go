@@ -0,0 +1,254 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/exported"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/internal/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/internal/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	cdc    *codec.Codec
+	ctx    sdk.Context
+	keeper keeper.Keeper
+
+	addr  sdk.AccAddress
+	addr2 sdk.AccAddress
+	addr3 sdk.AccAddress
+	addr4 sdk.AccAddress
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(false)
+
+	suite.cdc = app.Codec()
+	suite.ctx = app.BaseApp.NewContext(false, abci.Header{Height: 1})
+	suite.keeper = app.FeeGrantKeeper
+
+	suite.addr = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	suite.addr2 = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	suite.addr3 = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	suite.addr4 = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+}
+
+func (suite *KeeperTestSuite) TestKeeperCrud() {
+	ctx := suite.ctx
+	k := suite.keeper
+
+	// some helpers
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 555))
+	eth := sdk.NewCoins(sdk.NewInt64Coin("eth", 123))
+	basic := &types.BasicFeeAllowance{
+		SpendLimit: atom,
+		Expiration: types.ExpiresAtHeight(334455),
+	}
+	basic2 := &types.BasicFeeAllowance{
+		SpendLimit: eth,
+		Expiration: types.ExpiresAtHeight(172436),
+	}
+
+	// let's set up some initial state here
+	k.GrantFeeAllowance(ctx, types.NewFeeAllowanceGrant(suite.addr, suite.addr2, basic))
+	k.GrantFeeAllowance(ctx, types.NewFeeAllowanceGrant(suite.addr, suite.addr3, basic2))
+	k.GrantFeeAllowance(ctx, types.NewFeeAllowanceGrant(suite.addr2, suite.addr3, basic))
+	k.GrantFeeAllowance(ctx, types.NewFeeAllowanceGrant(suite.addr2, suite.addr4, basic))
+	k.GrantFeeAllowance(ctx, types.NewFeeAllowanceGrant(suite.addr4, suite.addr3, basic))
+
+	// remove some, overwrite other
+	suite.Require().NoError(k.RevokeFeeAllowance(ctx, suite.addr, suite.addr2))
+	suite.Require().NoError(k.RevokeFeeAllowance(ctx, suite.addr, suite.addr3))
+	k.GrantFeeAllowance(ctx, types.NewFeeAllowanceGrant(suite.addr, suite.addr3, basic))
+	k.GrantFeeAllowance(ctx, types.NewFeeAllowanceGrant(suite.addr2, suite.addr3, basic2))
+
+	// revoking a missing grant fails
+	suite.Require().Error(k.RevokeFeeAllowance(ctx, suite.addr, suite.addr2))
+
+	// end state:
+	// addr -> addr3 (basic)
+	// addr2 -> addr3 (basic2), addr4(basic)
+	// addr4 -> addr3 (basic)
+
+	// then lots of queries
+	cases := map[string]struct {
+		grantee   sdk.AccAddress
+		granter   sdk.AccAddress
+		allowance exported.FeeAllowance
+	}{
+		"addr revoked": {
+			granter: suite.addr,
+			grantee: suite.addr2,
+		},
+		"addr revoked and added": {
+			granter:   suite.addr,
+			grantee:   suite.addr3,
+			allowance: basic,
+		},
+		"addr never there": {
+			granter: suite.addr,
+			grantee: suite.addr4,
+		},
+		"addr modified": {
+			granter:   suite.addr2,
+			grantee:   suite.addr3,
+			allowance: basic2,
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+
+		suite.Run(name, func() {
+			allow := k.GetFeeAllowance(ctx, tc.granter, tc.grantee)
+			if tc.allowance == nil {
+				suite.Nil(allow)
+				return
+			}
+			suite.NotNil(allow)
+			suite.Equal(tc.allowance, allow)
+		})
+	}
+
+	allCases := map[string]struct {
+		grantee sdk.AccAddress
+		grants  []types.FeeAllowanceGrant
+	}{
+		"addr2 has none": {
+			grantee: suite.addr2,
+		},
+		"addr has one": {
+			grantee: suite.addr4,
+			grants:  []types.FeeAllowanceGrant{types.NewFeeAllowanceGrant(suite.addr2, suite.addr4, basic)},
+		},
+		"addr3 has three": {
+			grantee: suite.addr3,
+			grants: []types.FeeAllowanceGrant{
+				types.NewFeeAllowanceGrant(suite.addr, suite.addr3, basic),
+				types.NewFeeAllowanceGrant(suite.addr2, suite.addr3, basic2),
+				types.NewFeeAllowanceGrant(suite.addr4, suite.addr3, basic),
+			},
+		},
+	}
+
+	for name, tc := range allCases {
+		tc := tc
+
+		suite.Run(name, func() {
+			var grants []types.FeeAllowanceGrant
+			k.IterateAllGranteeFeeAllowances(ctx, tc.grantee, func(grant types.FeeAllowanceGrant) bool {
+				grants = append(grants, grant)
+				return false
+			})
+
+			suite.Require().ElementsMatch(tc.grants, grants)
+		})
+	}
+
+	suite.Require().Len(k.GetAllFeeAllowances(ctx), 4)
+}
+
+func (suite *KeeperTestSuite) TestUseGrantedFee() {
+	ctx := suite.ctx
+	k := suite.keeper
+
+	// some helpers
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 555))
+	eth := sdk.NewCoins(sdk.NewInt64Coin("eth", 123))
+	future := &types.BasicFeeAllowance{
+		SpendLimit: atom,
+		Expiration: types.ExpiresAtHeight(5678),
+	}
+	expired := &types.BasicFeeAllowance{
+		SpendLimit: eth,
+		Expiration: types.ExpiresAtHeight(55),
+	}
+
+	// for testing limits of the contract
+	hugeAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 9999))
+	smallAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 1))
+	futureAfterSmall := &types.BasicFeeAllowance{
+		SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 554)),
+		Expiration: types.ExpiresAtHeight(5678),
+	}
+
+	// then lots of queries
+	cases := map[string]struct {
+		grantee sdk.AccAddress
+		granter sdk.AccAddress
+		fee     sdk.Coins
+		allowed bool
+		final   exported.FeeAllowance
+	}{
+		"use entire pot": {
+			granter: suite.addr,
+			grantee: suite.addr2,
+			fee:     atom,
+			allowed: true,
+			final:   nil,
+		},
+		"expired and removed": {
+			granter: suite.addr,
+			grantee: suite.addr3,
+			fee:     eth,
+			allowed: false,
+			final:   nil,
+		},
+		"too high": {
+			granter: suite.addr,
+			grantee: suite.addr2,
+			fee:     hugeAtom,
+			allowed: false,
+			final:   future,
+		},
+		"use a little": {
+			granter: suite.addr,
+			grantee: suite.addr2,
+			fee:     smallAtom,
+			allowed: true,
+			final:   futureAfterSmall,
+		},
+		"no allowance": {
+			granter: suite.addr2,
+			grantee: suite.addr,
+			fee:     smallAtom,
+			allowed: false,
+			final:   nil,
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+
+		suite.Run(name, func() {
+			// let's set up some initial state here
+			// addr -> addr2 (future)
+			// addr -> addr3 (expired)
+			ctx := ctx.WithBlockHeight(100)
+			k.GrantFeeAllowance(ctx, types.NewFeeAllowanceGrant(suite.addr, suite.addr2, future))
+			k.GrantFeeAllowance(ctx, types.NewFeeAllowanceGrant(suite.addr, suite.addr3, expired))
+
+			err := k.UseGrantedFees(ctx, tc.granter, tc.grantee, tc.fee)
+			if tc.allowed {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+
+			loaded := k.GetFeeAllowance(ctx, tc.granter, tc.grantee)
+			suite.Equal(tc.final, loaded)
+		})
+	}
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
@@ -0,0 +1,73 @@
+package keeper_test
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/internal/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/internal/types"
+)
+
+func (suite *KeeperTestSuite) TestQuery() {
+	ctx := suite.ctx
+	k := suite.keeper
+
+	// some helpers
+	grant1 := types.NewFeeAllowanceGrant(suite.addr, suite.addr3, &types.BasicFeeAllowance{
+		SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 555)),
+		Expiration: types.ExpiresAtHeight(334455),
+	})
+	grant2 := types.NewFeeAllowanceGrant(suite.addr2, suite.addr3, &types.BasicFeeAllowance{
+		SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("eth", 123)),
+		Expiration: types.ExpiresAtHeight(334455),
+	})
+
+	// let's set up some initial state here
+	k.GrantFeeAllowance(ctx, grant1)
+	k.GrantFeeAllowance(ctx, grant2)
+
+	// now try some queries
+	cases := map[string]struct {
+		path  []string
+		query types.QueryFeeAllowancesParams
+		valid bool
+		res   []types.FeeAllowanceGrant
+	}{
+		"bad path": {
+			path:  []string{"foo", "bar"},
+			valid: false,
+		},
+		"no data": {
+			path:  []string{types.QueryGetFeeAllowances},
+			query: types.NewQueryFeeAllowancesParams(suite.addr),
+			valid: true,
+			res:   []types.FeeAllowanceGrant{},
+		},
+		"two grants": {
+			path:  []string{types.QueryGetFeeAllowances},
+			query: types.NewQueryFeeAllowancesParams(suite.addr3),
+			valid: true,
+			res:   []types.FeeAllowanceGrant{grant1, grant2},
+		},
+	}
+
+	querier := keeper.NewQuerier(k)
+	for name, tc := range cases {
+		tc := tc
+
+		suite.Run(name, func() {
+			req := abci.RequestQuery{Data: suite.cdc.MustMarshalJSON(tc.query)}
+
+			bz, err := querier(ctx, tc.path, req)
+			if !tc.valid {
+				suite.Error(err)
+				return
+			}
+			suite.NoError(err)
+
+			var grants []types.FeeAllowanceGrant
+			suite.NoError(suite.cdc.UnmarshalJSON(bz, &grants))
+			suite.ElementsMatch(tc.res, grants)
+		})
+	}
+}
@@ -0,0 +1,184 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/feegrant/internal/types"
+)
+
+func TestExpiresAt(t *testing.T) {
+	now := time.Now()
+
+	testCases := map[string]struct {
+		example types.ExpiresAt
+		valid   bool
+		zero    bool
+		before  types.ExpiresAt
+		after   types.ExpiresAt
+	}{
+		"empty": {
+			example: types.ExpiresAt{},
+			valid:   true,
+			zero:    true,
+		},
+		"basic": {
+			example: types.ExpiresAtHeight(100),
+			valid:   true,
+			before:  types.ExpiresAt{Height: 50, Time: now},
+			after:   types.ExpiresAt{Height: 122, Time: now},
+		},
+		"zero": {
+			example: types.ExpiresAtHeight(0),
+			valid:   true,
+			zero:    true,
+			before:  types.ExpiresAtHeight(1),
+		},
+		"double": {
+			example: types.ExpiresAt{Height: 100, Time: now},
+			valid:   false,
+		},
+		"match height": {
+			example: types.ExpiresAtHeight(1000),
+			valid:   true,
+			before:  types.ExpiresAtHeight(999),
+			after:   types.ExpiresAtHeight(1000),
+		},
+		"match time": {
+			example: types.ExpiresAtTime(now),
+			valid:   true,
+			before:  types.ExpiresAtTime(now.Add(-1 * time.Second)),
+			after:   types.ExpiresAtTime(now.Add(1 * time.Second)),
+		},
+		"negative height": {
+			example: types.ExpiresAtHeight(-1),
+			valid:   false,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		t.Run(name, func(t *testing.T) {
+			err := tc.example.ValidateBasic()
+			require.Equal(t, tc.zero, tc.example.IsZero())
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			if !tc.before.IsZero() {
+				require.False(t, tc.example.IsExpired(tc.before.Time, tc.before.Height))
+			}
+			if !tc.after.IsZero() {
+				require.True(t, tc.example.IsExpired(tc.after.Time, tc.after.Height))
+			}
+		})
+	}
+}
+
+func TestDurationValid(t *testing.T) {
+	now := time.Now()
+
+	testCases := map[string]struct {
+		period     types.Duration
+		valid      bool
+		compatible types.ExpiresAt
+		incompat   types.ExpiresAt
+	}{
+		"empty": {
+			period: types.Duration{},
+			valid:  false,
+		},
+		"height": {
+			period:     types.BlockDuration(100),
+			valid:      true,
+			compatible: types.ExpiresAtHeight(50),
+			incompat:   types.ExpiresAtTime(now),
+		},
+		"time": {
+			period:     types.ClockDuration(time.Hour),
+			valid:      true,
+			compatible: types.ExpiresAtTime(now),
+			incompat:   types.ExpiresAtHeight(50),
+		},
+		"zero": {
+			period: types.BlockDuration(0),
+			valid:  false,
+		},
+		"double": {
+			period: types.Duration{Block: 100, Clock: time.Hour},
+			valid:  false,
+		},
+		"negative clock": {
+			period: types.ClockDuration(-1 * time.Hour),
+			valid:  false,
+		},
+		"negative block": {
+			period: types.BlockDuration(-5),
+			valid:  false,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		t.Run(name, func(t *testing.T) {
+			err := tc.period.ValidateBasic()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.True(t, tc.compatible.IsCompatible(tc.period))
+			require.False(t, tc.incompat.IsCompatible(tc.period))
+		})
+	}
+}
+
+func TestDurationStep(t *testing.T) {
+	now := time.Now()
+
+	testCases := map[string]struct {
+		expires types.ExpiresAt
+		period  types.Duration
+		valid   bool
+		result  types.ExpiresAt
+	}{
+		"add height": {
+			expires: types.ExpiresAtHeight(789),
+			period:  types.BlockDuration(100),
+			valid:   true,
+			result:  types.ExpiresAtHeight(889),
+		},
+		"add time": {
+			expires: types.ExpiresAtTime(now),
+			period:  types.ClockDuration(time.Hour),
+			valid:   true,
+			result:  types.ExpiresAtTime(now.Add(time.Hour)),
+		},
+		"mismatch": {
+			expires: types.ExpiresAtHeight(789),
+			period:  types.ClockDuration(time.Hour),
+			valid:   false,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		t.Run(name, func(t *testing.T) {
+			next, err := tc.expires.Step(tc.period)
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.result, next)
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/internal/types"
+)
+
+func TestMsgGrantFeeAllowance(t *testing.T) {
+	granter := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	grantee := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	allowance := &types.BasicFeeAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 10))}
+
+	testCases := map[string]struct {
+		msg   types.MsgGrantFeeAllowance
+		valid bool
+	}{
+		"valid":             {types.NewMsgGrantFeeAllowance(granter, grantee, allowance), true},
+		"missing granter":   {types.NewMsgGrantFeeAllowance(nil, grantee, allowance), false},
+		"missing grantee":   {types.NewMsgGrantFeeAllowance(granter, nil, allowance), false},
+		"self grant":        {types.NewMsgGrantFeeAllowance(granter, granter, allowance), false},
+		"missing allowance": {types.NewMsgGrantFeeAllowance(granter, grantee, nil), false},
+		"invalid allowance": {
+			types.NewMsgGrantFeeAllowance(granter, grantee, &types.BasicFeeAllowance{Expiration: types.ExpiresAtHeight(-1)}),
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, []sdk.AccAddress{granter}, tc.msg.GetSigners())
+			require.NotPanics(t, func() { tc.msg.GetSignBytes() })
+		})
+	}
+}
+
+func TestMsgRevokeFeeAllowance(t *testing.T) {
+	granter := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	grantee := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	msg := types.NewMsgRevokeFeeAllowance(granter, grantee)
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(t, []sdk.AccAddress{granter}, msg.GetSigners())
+
+	require.Error(t, types.NewMsgRevokeFeeAllowance(nil, grantee).ValidateBasic())
+	require.Error(t, types.NewMsgRevokeFeeAllowance(granter, nil).ValidateBasic())
+}
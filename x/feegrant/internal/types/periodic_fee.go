@@ -0,0 +1,125 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/exported"
+)
+
+// PeriodicFeeAllowance extends FeeAllowance to allow for both a maximum cap,
+// as well as a limit per time period.
+type PeriodicFeeAllowance struct {
+	Basic BasicFeeAllowance `json:"basic" yaml:"basic"`
+
+	// Period is the duration of one period
+	Period Duration `json:"period" yaml:"period"`
+	// PeriodSpendLimit is the maximum amount of tokens to be spent in this period
+	PeriodSpendLimit sdk.Coins `json:"period_spend_limit" yaml:"period_spend_limit"`
+
+	// PeriodCanSpend is how much is available until PeriodReset
+	PeriodCanSpend sdk.Coins `json:"period_can_spend" yaml:"period_can_spend"`
+
+	// PeriodReset is when the PeriodCanSpend is updated with the
+	// PeriodSpendLimit, and the PeriodReset is moved forward one Period.
+	// A zero PeriodReset starts the first period upon first use.
+	PeriodReset ExpiresAt `json:"period_reset" yaml:"period_reset"`
+}
+
+var _ exported.FeeAllowance = (*PeriodicFeeAllowance)(nil)
+
+// Accept implements FeeAllowance. The fee is deducted from both the amount left
+// in the current period and the basic allowance's SpendLimit, the period being
+// topped up first if its reset point has been reached.
+func (a *PeriodicFeeAllowance) Accept(fee sdk.Coins, blockTime time.Time, blockHeight int64) (bool, error) {
+	if a.Basic.Expiration.IsExpired(blockTime, blockHeight) {
+		return true, sdkerrors.Wrap(ErrFeeLimitExpired, "absolute limit")
+	}
+
+	a.tryResetPeriod(blockTime, blockHeight)
+
+	// deduct from both the current period and the max amount
+	var isNeg bool
+	a.PeriodCanSpend, isNeg = a.PeriodCanSpend.SafeSub(fee)
+	if isNeg {
+		return false, sdkerrors.Wrap(ErrFeeLimitExceeded, "period limit")
+	}
+
+	if a.Basic.SpendLimit.Empty() {
+		return false, nil
+	}
+
+	a.Basic.SpendLimit, isNeg = a.Basic.SpendLimit.SafeSub(fee)
+	if isNeg {
+		return false, sdkerrors.Wrap(ErrFeeLimitExceeded, "absolute limit")
+	}
+
+	return a.Basic.SpendLimit.IsZero(), nil
+}
+
+// tryResetPeriod will check if the PeriodReset has been hit. If not, it is a no-op.
+// If we hit the reset period, it will top up the PeriodCanSpend amount to
+// min(PeriodSpendLimit, Basic.SpendLimit) so it is never more than the maximum allowed.
+// It will also update the PeriodReset. If we are within one Period, it will update from the
+// last PeriodReset (eg. if you always do one tx per day, it will always reset the same time)
+// If we are more then one period out (eg. no activity in a week), reset is one Period from the execution of this method
+func (a *PeriodicFeeAllowance) tryResetPeriod(blockTime time.Time, blockHeight int64) {
+	if !a.PeriodReset.IsZero() && !a.PeriodReset.IsExpired(blockTime, blockHeight) {
+		return
+	}
+
+	// set CanSpend to the lesser of PeriodSpendLimit and the TotalLimit
+	if _, isNeg := a.Basic.SpendLimit.SafeSub(a.PeriodSpendLimit); isNeg && !a.Basic.SpendLimit.Empty() {
+		a.PeriodCanSpend = a.Basic.SpendLimit
+	} else {
+		a.PeriodCanSpend = a.PeriodSpendLimit
+	}
+
+	// If we are within the period, step from expiration (eg. if you always do one tx per day, it will always reset the same time)
+	// If we are more then one period out (eg. no activity in a week), reset is one period from this time
+	if !a.PeriodReset.IsZero() {
+		a.PeriodReset = a.PeriodReset.MustStep(a.Period)
+		if !a.PeriodReset.IsExpired(blockTime, blockHeight) {
+			return
+		}
+	}
+
+	if a.Period.Clock != 0 {
+		a.PeriodReset = ExpiresAtTime(blockTime).MustStep(a.Period)
+	} else {
+		a.PeriodReset = ExpiresAtHeight(blockHeight).MustStep(a.Period)
+	}
+}
+
+// ValidateBasic implements FeeAllowance and enforces basic sanity checks
+func (a PeriodicFeeAllowance) ValidateBasic() error {
+	if err := a.Basic.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if !a.PeriodSpendLimit.IsValid() || a.PeriodSpendLimit.Empty() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "spend amount is invalid: %s", a.PeriodSpendLimit)
+	}
+	if !a.PeriodCanSpend.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "can spend amount is invalid: %s", a.PeriodCanSpend)
+	}
+
+	// ensure PeriodSpendLimit can be subtracted from total (same coin types)
+	if !a.Basic.SpendLimit.Empty() && !a.PeriodSpendLimit.DenomsSubsetOf(a.Basic.SpendLimit) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "period spend limit has different currency than basic spend limit")
+	}
+
+	// check times
+	if err := a.Period.ValidateBasic(); err != nil {
+		return err
+	}
+	if err := a.PeriodReset.ValidateBasic(); err != nil {
+		return err
+	}
+	if !a.PeriodReset.IsZero() && !a.PeriodReset.IsCompatible(a.Period) {
+		return sdkerrors.Wrap(ErrInvalidDuration, "period reset and period have different units")
+	}
+
+	return nil
+}
@@ -0,0 +1,246 @@
+package cli
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/libs/cli"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+)
+
+const (
+	flagVestingStart   = "vesting-start-time"
+	flagVestingEnd     = "vesting-end-time"
+	flagVestingAmt     = "vesting-amount"
+	flagVestingPeriods = "vesting-periods"
+)
+
+// vestingPeriod is the JSON representation of a single vesting period in the
+// file provided through the --vesting-periods flag, e.g.
+// [{"length": 2592000, "amount": "100stake"}].
+type vestingPeriod struct {
+	Length int64  `json:"length"`
+	Amount string `json:"amount"`
+}
+
+// AddGenesisAccountCmd returns the add-genesis-account cobra Command.
+func AddGenesisAccountCmd(
+	ctx *server.Context, cdc *codec.Codec, defaultNodeHome, defaultClientHome string,
+) *cobra.Command {
+
+	cmd := &cobra.Command{
+		Use:   "add-genesis-account [address_or_key_name] [coin][,[coin]]",
+		Short: "Add a genesis account to genesis.json",
+		Long: `Add a genesis account to genesis.json. The provided account must specify
+the account address or key name and a list of initial coins. If a key name is given,
+the address will be looked up in the local keyring. The list of initial tokens must
+contain valid denominations. Accounts may optionally be supplied with vesting parameters:
+
+--vesting-amount and --vesting-end-time create a delayed vesting account;
+--vesting-amount, --vesting-start-time and --vesting-end-time create a continuous vesting account;
+--vesting-start-time and --vesting-periods create a periodic vesting account, where the
+periods file holds a JSON list of {"length": <seconds>, "amount": "<coins>"} objects.
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config := ctx.Config
+			config.SetRoot(viper.GetString(cli.HomeFlag))
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				// attempt to lookup address from the keyring if no address was provided
+				inBuf := bufio.NewReader(cmd.InOrStdin())
+				kb, err := keys.NewKeyring(sdk.KeyringServiceName(),
+					viper.GetString(flags.FlagKeyringBackend), viper.GetString(flagClientHome), inBuf)
+				if err != nil {
+					return errors.Wrap(err, "failed to initialize keybase")
+				}
+
+				info, err := kb.Get(args[0])
+				if err != nil {
+					return errors.Wrap(err, "failed to get address from keybase")
+				}
+
+				addr = info.GetAddress()
+			}
+
+			coins, err := sdk.ParseCoins(args[1])
+			if err != nil {
+				return errors.Wrap(err, "failed to parse coins")
+			}
+
+			genAccount, err := newGenesisAccount(
+				addr, coins, viper.GetString(flagVestingAmt), viper.GetInt64(flagVestingStart),
+				viper.GetInt64(flagVestingEnd), viper.GetString(flagVestingPeriods),
+			)
+			if err != nil {
+				return err
+			}
+
+			genFile := config.GenesisFile()
+			appState, genDoc, err := genutil.GenesisStateFromGenFile(cdc, genFile)
+			if err != nil {
+				return errors.Wrap(err, "failed to unmarshal genesis state")
+			}
+
+			authGenState := auth.GetGenesisStateFromAppState(cdc, appState)
+			if authGenState.Accounts.Contains(addr) {
+				return fmt.Errorf("cannot add account at existing address %s", addr)
+			}
+
+			// add the new account to the set of genesis accounts and sanitize the
+			// accounts afterwards
+			authGenState.Accounts = append(authGenState.Accounts, genAccount)
+			authGenState.Accounts = auth.SanitizeGenesisAccounts(authGenState.Accounts)
+
+			authGenStateBz, err := cdc.MarshalJSON(authGenState)
+			if err != nil {
+				return errors.Wrap(err, "failed to marshal auth genesis state")
+			}
+
+			appState[auth.ModuleName] = authGenStateBz
+
+			bankGenState := bank.GetGenesisStateFromAppState(cdc, appState)
+			bankGenState.Balances = append(bankGenState.Balances, bank.Balance{Address: addr, Coins: coins.Sort()})
+			bankGenState.Balances = bank.SanitizeGenesisBalances(bankGenState.Balances)
+
+			bankGenStateBz, err := cdc.MarshalJSON(bankGenState)
+			if err != nil {
+				return errors.Wrap(err, "failed to marshal bank genesis state")
+			}
+
+			appState[bank.ModuleName] = bankGenStateBz
+
+			appStateJSON, err := cdc.MarshalJSON(appState)
+			if err != nil {
+				return errors.Wrap(err, "failed to marshal application genesis state")
+			}
+
+			genDoc.AppState = appStateJSON
+			return genutil.ExportGenesisFile(genDoc, genFile)
+		},
+	}
+
+	cmd.Flags().String(cli.HomeFlag, defaultNodeHome, "node's home directory")
+	cmd.Flags().String(flagClientHome, defaultClientHome, "client's home directory")
+	cmd.Flags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|test)")
+	cmd.Flags().String(flagVestingAmt, "", "amount of coins for vesting accounts")
+	cmd.Flags().Int64(flagVestingStart, 0, "schedule start time (unix epoch) for vesting accounts")
+	cmd.Flags().Int64(flagVestingEnd, 0, "schedule end time (unix epoch) for vesting accounts")
+	cmd.Flags().String(flagVestingPeriods, "", "path to a JSON file of vesting periods for periodic vesting accounts")
+
+	return cmd
+}
+
+// newGenesisAccount creates the concrete genesis account type, either a base
+// account or one of the vesting account types, based on the given vesting
+// parameters. The account is validated before being returned.
+func newGenesisAccount(
+	addr sdk.AccAddress, coins sdk.Coins, vestingAmtStr string, vestingStart, vestingEnd int64, periodsFile string,
+) (authexported.GenesisAccount, error) {
+
+	vestingAmt, err := sdk.ParseCoins(vestingAmtStr)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse vesting amount")
+	}
+
+	var periods vesting.Periods
+	if periodsFile != "" {
+		periods, err = parseVestingPeriods(periodsFile)
+		if err != nil {
+			return nil, err
+		}
+
+		// the original vesting amount of a periodic vesting account is the sum of
+		// all its periods
+		if vestingAmt.IsZero() {
+			for _, p := range periods {
+				vestingAmt = vestingAmt.Add(p.Amount...)
+			}
+		}
+	}
+
+	var genAccount authexported.GenesisAccount
+
+	baseAccount := auth.NewBaseAccount(addr, nil, 0, 0)
+	if !vestingAmt.IsZero() {
+		if coins.IsZero() || vestingAmt.IsAnyGT(coins) {
+			return nil, errors.New("vesting amount cannot be greater than total amount")
+		}
+
+		switch {
+		case len(periods) != 0 && vestingStart != 0:
+			genAccount = vesting.NewPeriodicVestingAccount(baseAccount, vestingAmt.Sort(), vestingStart, periods)
+
+		case len(periods) != 0:
+			return nil, errors.New("invalid vesting parameters; periodic vesting accounts must supply a start time")
+
+		case vestingStart != 0 && vestingEnd != 0:
+			baseVestingAccount := vesting.NewBaseVestingAccount(baseAccount, vestingAmt.Sort(), vestingEnd)
+			genAccount = vesting.NewContinuousVestingAccountRaw(baseVestingAccount, vestingStart)
+
+		case vestingEnd != 0:
+			baseVestingAccount := vesting.NewBaseVestingAccount(baseAccount, vestingAmt.Sort(), vestingEnd)
+			genAccount = vesting.NewDelayedVestingAccountRaw(baseVestingAccount)
+
+		default:
+			return nil, errors.New("invalid vesting parameters; must supply start and end time, end time, or start time and periods")
+		}
+	} else {
+		genAccount = baseAccount
+	}
+
+	if err := genAccount.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate new genesis account")
+	}
+
+	return genAccount, nil
+}
+
+// parseVestingPeriods reads the vesting periods JSON file at the given path.
+func parseVestingPeriods(path string) (vesting.Periods, error) {
+	bz, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read vesting periods file")
+	}
+
+	var raw []vestingPeriod
+	if err := json.Unmarshal(bz, &raw); err != nil {
+		return nil, errors.Wrap(err, "failed to parse vesting periods file")
+	}
+
+	if len(raw) == 0 {
+		return nil, errors.New("vesting periods file must contain at least one period")
+	}
+
+	periods := make(vesting.Periods, len(raw))
+	for i, p := range raw {
+		if p.Length <= 0 {
+			return nil, fmt.Errorf("vesting period %d must have a positive length", i)
+		}
+
+		amount, err := sdk.ParseCoins(p.Amount)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse amount of vesting period %d", i)
+		}
+
+		periods[i] = vesting.Period{Length: p.Length, Amount: amount}
+	}
+
+	return periods, nil
+}
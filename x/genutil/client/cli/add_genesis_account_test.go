@@ -0,0 +1,181 @@
+package cli
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	tcmd "github.com/tendermint/tendermint/cmd/tendermint/commands"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/libs/cli"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/tests"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+)
+
+func writePeriodsFile(t *testing.T, content string) (string, func()) {
+	f, err := ioutil.TempFile("", "vesting-periods-*.json")
+	require.NoError(t, err)
+
+	_, err = f.WriteString(content)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	return f.Name(), func() { os.Remove(f.Name()) }
+}
+
+func TestNewGenesisAccount(t *testing.T) {
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+
+	periodsFile, cleanup := writePeriodsFile(t, `[{"length": 100, "amount": "300stake"}, {"length": 200, "amount": "700stake"}]`)
+	defer cleanup()
+
+	invalidPeriodsFile, cleanupInvalid := writePeriodsFile(t, `[{"length": 0, "amount": "300stake"}]`)
+	defer cleanupInvalid()
+
+	testCases := []struct {
+		name        string
+		vestingAmt  string
+		start, end  int64
+		periodsFile string
+		expectErr   bool
+		check       func(t *testing.T, acc interface{})
+	}{
+		{
+			name: "base account",
+			check: func(t *testing.T, acc interface{}) {
+				require.IsType(t, &auth.BaseAccount{}, acc)
+			},
+		},
+		{
+			name:       "delayed vesting account",
+			vestingAmt: "500stake",
+			end:        1000,
+			check: func(t *testing.T, acc interface{}) {
+				require.IsType(t, &vesting.DelayedVestingAccount{}, acc)
+			},
+		},
+		{
+			name:       "continuous vesting account",
+			vestingAmt: "500stake",
+			start:      500,
+			end:        1000,
+			check: func(t *testing.T, acc interface{}) {
+				require.IsType(t, &vesting.ContinuousVestingAccount{}, acc)
+			},
+		},
+		{
+			name:        "periodic vesting account",
+			start:       500,
+			periodsFile: periodsFile,
+			check: func(t *testing.T, acc interface{}) {
+				pva, ok := acc.(*vesting.PeriodicVestingAccount)
+				require.True(t, ok)
+				require.Equal(t, int64(500), pva.StartTime)
+				require.Equal(t, int64(800), pva.EndTime)
+				require.Equal(t, coins, pva.OriginalVesting)
+				require.Len(t, pva.VestingPeriods, 2)
+			},
+		},
+		{
+			name:        "periodic vesting account without start time",
+			periodsFile: periodsFile,
+			expectErr:   true,
+		},
+		{
+			name:        "periodic vesting account with mismatched vesting amount",
+			vestingAmt:  "500stake",
+			start:       500,
+			periodsFile: periodsFile,
+			expectErr:   true,
+		},
+		{
+			name:        "periodic vesting account with invalid period",
+			start:       500,
+			periodsFile: invalidPeriodsFile,
+			expectErr:   true,
+		},
+		{
+			name:       "vesting amount greater than total amount",
+			vestingAmt: "5000stake",
+			end:        1000,
+			expectErr:  true,
+		},
+		{
+			name:       "vesting amount without schedule",
+			vestingAmt: "500stake",
+			expectErr:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			acc, err := newGenesisAccount(addr, coins, tc.vestingAmt, tc.start, tc.end, tc.periodsFile)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, addr, acc.GetAddress())
+			tc.check(t, acc)
+		})
+	}
+}
+
+func TestAddGenesisAccountCmd(t *testing.T) {
+	defer server.SetupViper(t)()
+	defer setupClientHome(t)()
+
+	home, cleanup := tests.NewTestCaseDir(t)
+	defer cleanup()
+	viper.Set(cli.HomeFlag, home)
+
+	cfg, err := tcmd.ParseConfig()
+	require.NoError(t, err)
+
+	ctx := server.NewContext(cfg, log.NewNopLogger())
+	cdc := simapp.MakeCodec()
+	require.NoError(t, InitCmd(ctx, cdc, simapp.ModuleBasics, home).RunE(nil, []string{"appnode-test"}))
+
+	periodsFile, cleanupPeriods := writePeriodsFile(t, `[{"length": 100, "amount": "300stake"}, {"length": 200, "amount": "700stake"}]`)
+	defer cleanupPeriods()
+
+	viper.Set(flagVestingStart, 500)
+	viper.Set(flagVestingPeriods, periodsFile)
+
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	cmd := AddGenesisAccountCmd(ctx, cdc, home, viper.GetString(flagClientHome))
+	require.NoError(t, cmd.RunE(cmd, []string{addr.String(), "1000stake"}))
+
+	// the account cannot be added twice
+	require.Error(t, cmd.RunE(cmd, []string{addr.String(), "1000stake"}))
+
+	appState, _, err := genutil.GenesisStateFromGenFile(cdc, ctx.Config.GenesisFile())
+	require.NoError(t, err)
+
+	authGenState := auth.GetGenesisStateFromAppState(cdc, appState)
+	require.Len(t, authGenState.Accounts, 1)
+
+	pva, ok := authGenState.Accounts[0].(*vesting.PeriodicVestingAccount)
+	require.True(t, ok)
+	require.Equal(t, addr, pva.GetAddress())
+	require.Equal(t, int64(500), pva.StartTime)
+	require.Equal(t, int64(800), pva.EndTime)
+	require.Len(t, pva.VestingPeriods, 2)
+
+	bankGenState := bank.GetGenesisStateFromAppState(cdc, appState)
+	require.Equal(t, []bank.Balance{{Address: addr, Coins: sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))}}, bankGenState.Balances)
+}
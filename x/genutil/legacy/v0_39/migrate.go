@@ -1,12 +1,19 @@
 package v039
 
 import (
+	"encoding/json"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	v038auth "github.com/cosmos/cosmos-sdk/x/auth/legacy/v0_38"
 	v039auth "github.com/cosmos/cosmos-sdk/x/auth/legacy/v0_39"
 	v038bank "github.com/cosmos/cosmos-sdk/x/bank/legacy/v0_38"
 	v039bank "github.com/cosmos/cosmos-sdk/x/bank/legacy/v0_39"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
+	v034gov "github.com/cosmos/cosmos-sdk/x/gov/legacy/v0_34"
+	v039gov "github.com/cosmos/cosmos-sdk/x/gov/legacy/v0_39"
+	v038slashing "github.com/cosmos/cosmos-sdk/x/slashing/legacy/v0_38"
+	v039slashing "github.com/cosmos/cosmos-sdk/x/slashing/legacy/v0_39"
+	v039staking "github.com/cosmos/cosmos-sdk/x/staking/legacy/v0_39"
 )
 
 func Migrate(appState genutil.AppMap) genutil.AppMap {
@@ -51,5 +58,74 @@ func Migrate(appState genutil.AppMap) genutil.AppMap {
 		)
 	}
 
+	if appState[v039gov.ModuleName] != nil {
+		// Only the votes changed shape. The x/gov genesis state is kept as raw
+		// JSON so that proposals with custom content types are left untouched.
+		var govGenState map[string]json.RawMessage
+		if err := json.Unmarshal(appState[v039gov.ModuleName], &govGenState); err != nil {
+			panic(err)
+		}
+
+		if govGenState["votes"] != nil {
+			var votes v034gov.Votes
+			v038Codec.MustUnmarshalJSON(govGenState["votes"], &votes)
+
+			govGenState["votes"] = v039Codec.MustMarshalJSON(v039gov.Migrate(votes))
+		}
+
+		bz, err := json.Marshal(govGenState)
+		if err != nil {
+			panic(err)
+		}
+
+		appState[v039gov.ModuleName] = bz
+	}
+
+	if appState[v038slashing.ModuleName] != nil {
+		// Only the params changed shape, so the signing infos and missed blocks
+		// are kept as raw JSON.
+		var slashingGenState map[string]json.RawMessage
+		if err := json.Unmarshal(appState[v038slashing.ModuleName], &slashingGenState); err != nil {
+			panic(err)
+		}
+
+		if slashingGenState["params"] != nil {
+			var params v038slashing.Params
+			v038Codec.MustUnmarshalJSON(slashingGenState["params"], &params)
+
+			slashingGenState["params"] = v039Codec.MustMarshalJSON(v039slashing.Migrate(params))
+		}
+
+		bz, err := json.Marshal(slashingGenState)
+		if err != nil {
+			panic(err)
+		}
+
+		appState[v039slashing.ModuleName] = bz
+	}
+
+	if appState[v039staking.ModuleName] != nil {
+		// Only the params gained a field, so the remaining x/staking genesis
+		// state is kept as raw JSON.
+		var stakingGenState map[string]json.RawMessage
+		if err := json.Unmarshal(appState[v039staking.ModuleName], &stakingGenState); err != nil {
+			panic(err)
+		}
+
+		if stakingGenState["params"] != nil {
+			var params v039staking.Params
+			v038Codec.MustUnmarshalJSON(stakingGenState["params"], &params)
+
+			stakingGenState["params"] = v039Codec.MustMarshalJSON(v039staking.Migrate(params))
+		}
+
+		bz, err := json.Marshal(stakingGenState)
+		if err != nil {
+			panic(err)
+		}
+
+		appState[v039staking.ModuleName] = bz
+	}
+
 	return appState
 }
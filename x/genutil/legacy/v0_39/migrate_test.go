@@ -0,0 +1,129 @@
+package v039_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	v039 "github.com/cosmos/cosmos-sdk/x/genutil/legacy/v0_39"
+	"github.com/cosmos/cosmos-sdk/x/slashing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrateGovVotes(t *testing.T) {
+	govGenState := []byte(`{
+  "starting_proposal_id": "2",
+  "deposits": null,
+  "votes": [
+    {
+      "proposal_id": "1",
+      "voter": "cosmos1xxkueklal9vejv9unqu80w9vptyepfa95pd53u",
+      "option": "Abstain"
+    }
+  ],
+  "proposals": [
+    {
+      "content": {
+        "type": "cosmos-sdk/SoftwareUpgradeProposal",
+        "value": {
+          "title": "upgrade",
+          "description": "upgrade"
+        }
+      },
+      "id": "1"
+    }
+  ]
+}`)
+
+	migrated := v039.Migrate(genutil.AppMap{"gov": govGenState})
+
+	var govState map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(migrated["gov"], &govState))
+
+	// votes are migrated to weighted vote options
+	require.JSONEq(t, `[
+  {
+    "proposal_id": "1",
+    "voter": "cosmos1xxkueklal9vejv9unqu80w9vptyepfa95pd53u",
+    "options": [
+      {
+        "option": "Abstain",
+        "weight": "1.000000000000000000"
+      }
+    ]
+  }
+]`, string(govState["votes"]))
+
+	// proposals with content unknown to the legacy codec are left untouched
+	require.JSONEq(t, `[
+  {
+    "content": {
+      "type": "cosmos-sdk/SoftwareUpgradeProposal",
+      "value": {
+        "title": "upgrade",
+        "description": "upgrade"
+      }
+    },
+    "id": "1"
+  }
+]`, string(govState["proposals"]))
+	require.JSONEq(t, `"2"`, string(govState["starting_proposal_id"]))
+	require.JSONEq(t, `null`, string(govState["deposits"]))
+}
+
+func TestMigrateSlashingParams(t *testing.T) {
+	slashingGenState := []byte(`{
+  "params": {
+    "signed_blocks_window": "100",
+    "min_signed_per_window": "0.500000000000000000",
+    "downtime_jail_duration": "600000000000",
+    "slash_fraction_double_sign": "0.050000000000000000",
+    "slash_fraction_downtime": "0.010000000000000000"
+  },
+  "signing_infos": {},
+  "missed_blocks": {}
+}`)
+
+	migrated := v039.Migrate(genutil.AppMap{"slashing": slashingGenState})
+
+	// the migrated genesis state is valid for the current x/slashing module
+	var genState slashing.GenesisState
+	slashing.ModuleCdc.MustUnmarshalJSON(migrated["slashing"], &genState)
+	require.NoError(t, slashing.ValidateGenesis(genState))
+
+	require.Equal(t, slashing.DefaultParams(), genState.Params)
+	require.Empty(t, genState.SigningInfos)
+	require.Empty(t, genState.MissedBlocks)
+}
+
+func TestMigrateStakingParams(t *testing.T) {
+	stakingGenState := []byte(`{
+  "params": {
+    "unbonding_time": "1814400000000000",
+    "max_validators": 100,
+    "max_entries": 7,
+    "historical_entries": 3,
+    "bond_denom": "stake"
+  },
+  "last_total_power": "0",
+  "exported": false
+}`)
+
+	migrated := v039.Migrate(genutil.AppMap{"staking": stakingGenState})
+
+	var stakingState map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(migrated["staking"], &stakingState))
+
+	// the minimum commission rate defaults to zero
+	require.JSONEq(t, `{
+  "unbonding_time": "1814400000000000",
+  "max_validators": 100,
+  "max_entries": 7,
+  "historical_entries": 3,
+  "bond_denom": "stake",
+  "min_commission_rate": "0.000000000000000000"
+}`, string(stakingState["params"]))
+	require.JSONEq(t, `"0"`, string(stakingState["last_total_power"]))
+	require.JSONEq(t, `false`, string(stakingState["exported"]))
+}
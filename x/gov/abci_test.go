@@ -281,7 +281,7 @@ func TestProposalPassedEndblocker(t *testing.T) {
 	deposits := initialModuleAccCoins.Add(proposal.TotalDeposit...).Add(proposalCoins...)
 	require.True(t, moduleAccCoins.IsEqual(deposits))
 
-	err = input.keeper.AddVote(ctx, proposal.ProposalID, input.addrs[0], OptionYes)
+	err = input.keeper.AddVote(ctx, proposal.ProposalID, input.addrs[0], NewNonSplitVoteOption(OptionYes))
 	require.NoError(t, err)
 
 	newHeader := ctx.BlockHeader()
@@ -325,7 +325,7 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
-	err = input.keeper.AddVote(ctx, proposal.ProposalID, input.addrs[0], OptionYes)
+	err = input.keeper.AddVote(ctx, proposal.ProposalID, input.addrs[0], NewNonSplitVoteOption(OptionYes))
 	require.NoError(t, err)
 
 	newHeader := ctx.BlockHeader()
@@ -18,6 +18,7 @@ const (
 	DefaultParamspace     = types.DefaultParamspace
 	TypeMsgDeposit        = types.TypeMsgDeposit
 	TypeMsgVote           = types.TypeMsgVote
+	TypeMsgVoteWeighted   = types.TypeMsgVoteWeighted
 	TypeMsgSubmitProposal = types.TypeMsgSubmitProposal
 	StatusNil             = types.StatusNil
 	StatusDepositPeriod   = types.StatusDepositPeriod
@@ -85,6 +86,7 @@ var (
 	NewMsgSubmitProposal          = types.NewMsgSubmitProposal
 	NewMsgDeposit                 = types.NewMsgDeposit
 	NewMsgVote                    = types.NewMsgVote
+	NewMsgVoteWeighted            = types.NewMsgVoteWeighted
 	ParamKeyTable                 = types.ParamKeyTable
 	NewDepositParams              = types.NewDepositParams
 	NewTallyParams                = types.NewTallyParams
@@ -110,6 +112,14 @@ var (
 	NewVote                       = types.NewVote
 	VoteOptionFromString          = types.VoteOptionFromString
 	ValidVoteOption               = types.ValidVoteOption
+	NewWeightedVoteOption         = types.NewWeightedVoteOption
+	NewNonSplitVoteOption         = types.NewNonSplitVoteOption
+	WeightedVoteOptionsFromString = types.WeightedVoteOptionsFromString
+	ValidWeightedVoteOption       = types.ValidWeightedVoteOption
+
+	NewQueryProposalVotesParams = types.NewQueryProposalVotesParams
+	NewQueryProposalsResponse   = types.NewQueryProposalsResponse
+	NewQueryVotesResponse       = types.NewQueryVotesResponse
 
 	// variable aliases
 	ModuleCdc                   = types.ModuleCdc
@@ -134,6 +144,7 @@ type (
 	MsgSubmitProposal    = types.MsgSubmitProposal
 	MsgDeposit           = types.MsgDeposit
 	MsgVote              = types.MsgVote
+	MsgVoteWeighted      = types.MsgVoteWeighted
 	DepositParams        = types.DepositParams
 	TallyParams          = types.TallyParams
 	VotingParams         = types.VotingParams
@@ -152,4 +163,10 @@ type (
 	Vote                 = types.Vote
 	Votes                = types.Votes
 	VoteOption           = types.VoteOption
+	WeightedVoteOption   = types.WeightedVoteOption
+	WeightedVoteOptions  = types.WeightedVoteOptions
+
+	QueryProposalVotesParams = types.QueryProposalVotesParams
+	QueryProposalsResponse   = types.QueryProposalsResponse
+	QueryVotesResponse       = types.QueryVotesResponse
 )
@@ -95,22 +95,27 @@ $ %s query gov proposals --depositor cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39
 $ %s query gov proposals --voter cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
 $ %s query gov proposals --status (DepositPeriod|VotingPeriod|Passed|Rejected)
 $ %s query gov proposals --page=2 --limit=100
+$ %s query gov proposals --page-key=<next_key> --limit=100
 `,
 				version.ClientName, version.ClientName, version.ClientName, version.ClientName,
+				version.ClientName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			bechDepositorAddr := viper.GetString(flagDepositor)
 			bechVoterAddr := viper.GetString(flagVoter)
 			strProposalStatus := viper.GetString(flagStatus)
-			page := viper.GetInt(flags.FlagPage)
-			limit := viper.GetInt(flags.FlagLimit)
+
+			pageReq, err := client.ReadPageRequest()
+			if err != nil {
+				return err
+			}
 
 			var depositorAddr sdk.AccAddress
 			var voterAddr sdk.AccAddress
 			var proposalStatus types.ProposalStatus
 
-			params := types.NewQueryProposalsParams(page, limit, proposalStatus, voterAddr, depositorAddr)
+			params := types.NewQueryProposalsParams(pageReq, proposalStatus, voterAddr, depositorAddr)
 
 			if len(bechDepositorAddr) != 0 {
 				depositorAddr, err := sdk.AccAddressFromBech32(bechDepositorAddr)
@@ -148,13 +153,13 @@ $ %s query gov proposals --page=2 --limit=100
 				return err
 			}
 
-			var matchingProposals types.Proposals
+			var matchingProposals types.QueryProposalsResponse
 			err = cdc.UnmarshalJSON(res, &matchingProposals)
 			if err != nil {
 				return err
 			}
 
-			if len(matchingProposals) == 0 {
+			if len(matchingProposals.Proposals) == 0 {
 				return fmt.Errorf("no matching proposals found")
 			}
 
@@ -162,8 +167,7 @@ $ %s query gov proposals --page=2 --limit=100
 		},
 	}
 
-	cmd.Flags().Int(flags.FlagPage, 1, "pagination page of proposals to to query for")
-	cmd.Flags().Int(flags.FlagLimit, 100, "pagination limit of proposals to query for")
+	flags.AddPaginationFlagsToCmd(cmd, "proposals")
 	cmd.Flags().String(flagDepositor, "", "(optional) filter by proposals deposited on by depositor")
 	cmd.Flags().String(flagVoter, "", "(optional) filter by proposals voted on by voted")
 	cmd.Flags().String(flagStatus, "", "(optional) filter proposals by proposal status, status: deposit_period/voting_period/passed/rejected")
@@ -266,10 +270,12 @@ $ %[1]s query gov votes 1 --page=2 --limit=100
 				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
 			}
 
-			page := viper.GetInt(flags.FlagPage)
-			limit := viper.GetInt(flags.FlagLimit)
+			pageReq, err := client.ReadPageRequest()
+			if err != nil {
+				return err
+			}
 
-			params := types.NewQueryProposalVotesParams(proposalID, page, limit)
+			params := types.NewQueryProposalVotesParams(proposalID, pageReq)
 			bz, err := cdc.MarshalJSON(params)
 			if err != nil {
 				return err
@@ -295,13 +301,13 @@ $ %[1]s query gov votes 1 --page=2 --limit=100
 				return err
 			}
 
-			var votes types.Votes
+			var votes types.QueryVotesResponse
 			cdc.MustUnmarshalJSON(res, &votes)
 			return cliCtx.PrintOutput(votes)
 		},
 	}
-	cmd.Flags().Int(flags.FlagPage, 1, "pagination page of votes to to query for")
-	cmd.Flags().Int(flags.FlagLimit, 100, "pagination limit of votes to query for")
+
+	flags.AddPaginationFlagsToCmd(cmd, "votes")
 	return cmd
 }
 
@@ -1,11 +1,12 @@
 package utils
 
 import (
+	"errors"
 	"fmt"
 
-	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
@@ -76,18 +77,37 @@ func QueryDepositsByTxQuery(cliCtx context.CLIContext, params types.QueryProposa
 // QueryVotesByTxQuery will query for votes via a direct txs tags query. It
 // will fetch and build votes directly from the returned txs and return a JSON
 // marshalled result or any error that occurred.
+//
+// NOTE: the txs query only supports offset based pagination and does not
+// return a page response.
 func QueryVotesByTxQuery(cliCtx context.CLIContext, params types.QueryProposalVotesParams) ([]byte, error) {
+	pageReq := params.Pagination
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+
+	if len(pageReq.Key) > 0 {
+		return nil, errors.New("key based pagination is not supported for votes of inactive proposals")
+	}
+
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+
 	var (
+		// NOTE: the message action is not part of the query as votes may be cast
+		// by either MsgVote or MsgVoteWeighted, both of which emit the proposal
+		// vote event.
 		events = []string{
-			fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeyAction, types.TypeMsgVote),
 			fmt.Sprintf("%s.%s='%s'", types.EventTypeProposalVote, types.AttributeKeyProposalID, []byte(fmt.Sprintf("%d", params.ProposalID))),
 		}
 		votes      []types.Vote
 		nextTxPage = defaultPage
-		totalLimit = params.Limit * params.Page
+		totalLimit = pageReq.Offset + limit
 	)
 	// query interrupted either if we collected enough votes or tx indexer run out of relevant txs
-	for len(votes) < totalLimit {
+	for uint64(len(votes)) < totalLimit {
 		searchResult, err := authclient.QueryTxsByEvents(cliCtx, events, nextTxPage, defaultLimit)
 		if err != nil {
 			return nil, err
@@ -95,14 +115,8 @@ func QueryVotesByTxQuery(cliCtx context.CLIContext, params types.QueryProposalVo
 		nextTxPage++
 		for _, info := range searchResult.Txs {
 			for _, msg := range info.Tx.GetMsgs() {
-				if msg.Type() == types.TypeMsgVote {
-					voteMsg := msg.(types.MsgVote)
-
-					votes = append(votes, types.Vote{
-						Voter:      voteMsg.Voter,
-						ProposalID: params.ProposalID,
-						Option:     voteMsg.Option,
-					})
+				if vote, ok := voteFromMsg(msg, params.ProposalID); ok {
+					votes = append(votes, vote)
 				}
 			}
 		}
@@ -110,22 +124,26 @@ func QueryVotesByTxQuery(cliCtx context.CLIContext, params types.QueryProposalVo
 			break
 		}
 	}
-	start, end := client.Paginate(len(votes), params.Page, params.Limit, 100)
-	if start < 0 || end < 0 {
+	if pageReq.Offset >= uint64(len(votes)) {
 		votes = []types.Vote{}
 	} else {
-		votes = votes[start:end]
+		end := totalLimit
+		if end > uint64(len(votes)) {
+			end = uint64(len(votes))
+		}
+		votes = votes[pageReq.Offset:end]
 	}
+
+	res := types.NewQueryVotesResponse(votes, nil)
 	if cliCtx.Indent {
-		return cliCtx.Codec.MarshalJSONIndent(votes, "", "  ")
+		return cliCtx.Codec.MarshalJSONIndent(res, "", "  ")
 	}
-	return cliCtx.Codec.MarshalJSON(votes)
+	return cliCtx.Codec.MarshalJSON(res)
 }
 
 // QueryVoteByTxQuery will query for a single vote via a direct txs tags query.
 func QueryVoteByTxQuery(cliCtx context.CLIContext, params types.QueryVoteParams) ([]byte, error) {
 	events := []string{
-		fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeyAction, types.TypeMsgVote),
 		fmt.Sprintf("%s.%s='%s'", types.EventTypeProposalVote, types.AttributeKeyProposalID, []byte(fmt.Sprintf("%d", params.ProposalID))),
 		fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeySender, []byte(params.Voter.String())),
 	}
@@ -139,15 +157,7 @@ func QueryVoteByTxQuery(cliCtx context.CLIContext, params types.QueryVoteParams)
 	for _, info := range searchResult.Txs {
 		for _, msg := range info.Tx.GetMsgs() {
 			// there should only be a single vote under the given conditions
-			if msg.Type() == types.TypeMsgVote {
-				voteMsg := msg.(types.MsgVote)
-
-				vote := types.Vote{
-					Voter:      voteMsg.Voter,
-					ProposalID: params.ProposalID,
-					Option:     voteMsg.Option,
-				}
-
+			if vote, ok := voteFromMsg(msg, params.ProposalID); ok && vote.Voter.Equals(params.Voter) {
 				if cliCtx.Indent {
 					return cliCtx.Codec.MarshalJSONIndent(vote, "", "  ")
 				}
@@ -243,3 +253,22 @@ func QueryProposalByID(proposalID uint64, cliCtx context.CLIContext, queryRoute
 
 	return res, err
 }
+
+// voteFromMsg builds the vote cast on the given proposal by a MsgVote or a
+// MsgVoteWeighted. It returns false if the message is not a vote on the
+// proposal.
+func voteFromMsg(msg sdk.Msg, proposalID uint64) (types.Vote, bool) {
+	switch msg := msg.(type) {
+	case types.MsgVote:
+		if msg.ProposalID == proposalID {
+			return types.NewVote(proposalID, msg.Voter, types.NewNonSplitVoteOption(msg.Option)), true
+		}
+
+	case types.MsgVoteWeighted:
+		if msg.ProposalID == proposalID {
+			return types.NewVote(proposalID, msg.Voter, msg.Options), true
+		}
+	}
+
+	return types.Vote{}, false
+}
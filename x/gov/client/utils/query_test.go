@@ -12,6 +12,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
@@ -51,7 +52,8 @@ func newTestCodec() *codec.Codec {
 func TestGetPaginatedVotes(t *testing.T) {
 	type testCase struct {
 		description string
-		page, limit int
+		offset      uint64
+		limit       uint64
 		txs         []authtypes.StdTx
 		votes       []types.Vote
 	}
@@ -63,6 +65,10 @@ func TestGetPaginatedVotes(t *testing.T) {
 		types.NewMsgVote(acc1, 0, types.OptionYes),
 		types.NewMsgVote(acc1, 0, types.OptionYes),
 	}
+	weightedOptions := types.WeightedVoteOptions{
+		types.NewWeightedVoteOption(types.OptionYes, sdk.NewDecWithPrec(7, 1)),
+		types.NewWeightedVoteOption(types.OptionAbstain, sdk.NewDecWithPrec(3, 1)),
+	}
 	acc2Msgs := []sdk.Msg{
 		types.NewMsgVote(acc2, 0, types.OptionYes),
 		types.NewMsgVote(acc2, 0, types.OptionYes),
@@ -70,60 +76,65 @@ func TestGetPaginatedVotes(t *testing.T) {
 	for _, tc := range []testCase{
 		{
 			description: "1MsgPerTxAll",
-			page:        1,
+			offset:      0,
 			limit:       2,
 			txs: []authtypes.StdTx{
 				{Msgs: acc1Msgs[:1]},
 				{Msgs: acc2Msgs[:1]},
 			},
 			votes: []types.Vote{
-				types.NewVote(0, acc1, types.OptionYes),
-				types.NewVote(0, acc2, types.OptionYes)},
+				types.NewVote(0, acc1, types.NewNonSplitVoteOption(types.OptionYes)),
+				types.NewVote(0, acc2, types.NewNonSplitVoteOption(types.OptionYes))},
 		},
 
 		{
 			description: "2MsgPerTx1Chunk",
-			page:        1,
+			offset:      0,
 			limit:       2,
 			txs: []authtypes.StdTx{
 				{Msgs: acc1Msgs},
 				{Msgs: acc2Msgs},
 			},
 			votes: []types.Vote{
-				types.NewVote(0, acc1, types.OptionYes),
-				types.NewVote(0, acc1, types.OptionYes)},
+				types.NewVote(0, acc1, types.NewNonSplitVoteOption(types.OptionYes)),
+				types.NewVote(0, acc1, types.NewNonSplitVoteOption(types.OptionYes))},
 		},
 		{
 			description: "2MsgPerTx2Chunk",
-			page:        2,
+			offset:      2,
 			limit:       2,
 			txs: []authtypes.StdTx{
 				{Msgs: acc1Msgs},
 				{Msgs: acc2Msgs},
 			},
 			votes: []types.Vote{
-				types.NewVote(0, acc2, types.OptionYes),
-				types.NewVote(0, acc2, types.OptionYes)},
+				types.NewVote(0, acc2, types.NewNonSplitVoteOption(types.OptionYes)),
+				types.NewVote(0, acc2, types.NewNonSplitVoteOption(types.OptionYes))},
 		},
 		{
 			description: "IncompleteSearchTx",
-			page:        1,
+			offset:      0,
 			limit:       2,
 			txs: []authtypes.StdTx{
 				{Msgs: acc1Msgs[:1]},
 			},
-			votes: []types.Vote{types.NewVote(0, acc1, types.OptionYes)},
+			votes: []types.Vote{types.NewVote(0, acc1, types.NewNonSplitVoteOption(types.OptionYes))},
 		},
 		{
-			description: "InvalidPage",
-			page:        -1,
+			description: "WeightedVotes",
+			offset:      0,
+			limit:       2,
 			txs: []authtypes.StdTx{
 				{Msgs: acc1Msgs[:1]},
+				{Msgs: []sdk.Msg{types.NewMsgVoteWeighted(acc2, 0, weightedOptions)}},
 			},
+			votes: []types.Vote{
+				types.NewVote(0, acc1, types.NewNonSplitVoteOption(types.OptionYes)),
+				types.NewVote(0, acc2, weightedOptions)},
 		},
 		{
 			description: "OutOfBounds",
-			page:        2,
+			offset:      10,
 			limit:       10,
 			txs: []authtypes.StdTx{
 				{Msgs: acc1Msgs[:1]},
@@ -144,15 +155,21 @@ func TestGetPaginatedVotes(t *testing.T) {
 			client := TxSearchMock{txs: marshalled}
 			ctx := context.CLIContext{}.WithCodec(cdc).WithTrustNode(true).WithClient(client)
 
-			params := types.NewQueryProposalVotesParams(0, tc.page, tc.limit)
+			params := types.NewQueryProposalVotesParams(0, query.NewPageRequest(nil, tc.offset, tc.limit, false))
 			votesData, err := QueryVotesByTxQuery(ctx, params)
 			require.NoError(t, err)
-			votes := []types.Vote{}
-			require.NoError(t, ctx.Codec.UnmarshalJSON(votesData, &votes))
+			res := types.QueryVotesResponse{}
+			require.NoError(t, ctx.Codec.UnmarshalJSON(votesData, &res))
+			votes := res.Votes
 			require.Equal(t, len(tc.votes), len(votes))
 			for i := range votes {
 				require.Equal(t, tc.votes[i], votes[i])
 			}
 		})
 	}
+	// votes of inactive proposals can only be paginated by offset
+	ctx := context.CLIContext{}.WithCodec(newTestCodec()).WithTrustNode(true).WithClient(TxSearchMock{})
+	params := types.NewQueryProposalVotesParams(0, query.NewPageRequest([]byte("key"), 0, 10, false))
+	_, err := QueryVotesByTxQuery(ctx, params)
+	require.Error(t, err)
 }
@@ -3,9 +3,10 @@ package keeper
 import (
 	"fmt"
 
-	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
@@ -112,11 +113,17 @@ func (keeper Keeper) GetProposals(ctx sdk.Context) (proposals types.Proposals) {
 //
 // NOTE: If no filters are provided, all proposals will be returned in paginated
 // form.
-func (keeper Keeper) GetProposalsFiltered(ctx sdk.Context, params types.QueryProposalsParams) []types.Proposal {
-	proposals := keeper.GetProposals(ctx)
-	filteredProposals := make([]types.Proposal, 0, len(proposals))
+func (keeper Keeper) GetProposalsFiltered(
+	ctx sdk.Context, params types.QueryProposalsParams,
+) (types.Proposals, *query.PageResponse, error) {
+
+	proposals := types.Proposals{}
+	store := prefix.NewStore(ctx.KVStore(keeper.storeKey), types.ProposalsKeyPrefix)
+
+	pageRes, err := query.FilteredPaginate(store, params.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
+		var p types.Proposal
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(value, &p)
 
-	for _, p := range proposals {
 		matchVoter, matchDepositor, matchStatus := true, true, true
 
 		// match status (if supplied/valid)
@@ -134,19 +141,21 @@ func (keeper Keeper) GetProposalsFiltered(ctx sdk.Context, params types.QueryPro
 			_, matchDepositor = keeper.GetDeposit(ctx, p.ProposalID, params.Depositor)
 		}
 
-		if matchVoter && matchDepositor && matchStatus {
-			filteredProposals = append(filteredProposals, p)
+		if !(matchVoter && matchDepositor && matchStatus) {
+			return false, nil
 		}
-	}
 
-	start, end := client.Paginate(len(filteredProposals), params.Page, params.Limit, 100)
-	if start < 0 || end < 0 {
-		filteredProposals = []types.Proposal{}
-	} else {
-		filteredProposals = filteredProposals[start:end]
+		if accumulate {
+			proposals = append(proposals, p)
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return filteredProposals
+	return proposals, pageRes, nil
 }
 
 // GetProposalID gets the highest proposal ID
@@ -10,6 +10,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
@@ -137,7 +138,7 @@ func TestGetProposalsFiltered(t *testing.T) {
 
 			if i%2 == 0 {
 				d := types.NewDeposit(proposalID, addr1, nil)
-				v := types.NewVote(proposalID, addr1, types.OptionYes)
+				v := types.NewVote(proposalID, addr1, types.NewNonSplitVoteOption(types.OptionYes))
 				keeper.SetDeposit(ctx, d)
 				keeper.SetVote(ctx, v)
 			}
@@ -151,22 +152,23 @@ func TestGetProposalsFiltered(t *testing.T) {
 		params             types.QueryProposalsParams
 		expectedNumResults int
 	}{
-		{types.NewQueryProposalsParams(1, 50, types.StatusNil, nil, nil), 50},
-		{types.NewQueryProposalsParams(1, 50, types.StatusDepositPeriod, nil, nil), 50},
-		{types.NewQueryProposalsParams(1, 50, types.StatusVotingPeriod, nil, nil), 50},
-		{types.NewQueryProposalsParams(1, 25, types.StatusNil, nil, nil), 25},
-		{types.NewQueryProposalsParams(2, 25, types.StatusNil, nil, nil), 25},
-		{types.NewQueryProposalsParams(1, 50, types.StatusRejected, nil, nil), 0},
-		{types.NewQueryProposalsParams(1, 50, types.StatusNil, addr1, nil), 50},
-		{types.NewQueryProposalsParams(1, 50, types.StatusNil, nil, addr1), 50},
-		{types.NewQueryProposalsParams(1, 50, types.StatusNil, addr1, addr1), 50},
-		{types.NewQueryProposalsParams(1, 50, types.StatusDepositPeriod, addr1, addr1), 25},
-		{types.NewQueryProposalsParams(1, 50, types.StatusDepositPeriod, nil, nil), 50},
-		{types.NewQueryProposalsParams(1, 50, types.StatusVotingPeriod, nil, nil), 50},
+		{types.NewQueryProposalsParams(query.NewPageRequest(nil, 0, 50, false), types.StatusNil, nil, nil), 50},
+		{types.NewQueryProposalsParams(query.NewPageRequest(nil, 0, 50, false), types.StatusDepositPeriod, nil, nil), 50},
+		{types.NewQueryProposalsParams(query.NewPageRequest(nil, 0, 50, false), types.StatusVotingPeriod, nil, nil), 50},
+		{types.NewQueryProposalsParams(query.NewPageRequest(nil, 0, 25, false), types.StatusNil, nil, nil), 25},
+		{types.NewQueryProposalsParams(query.NewPageRequest(nil, 25, 25, false), types.StatusNil, nil, nil), 25},
+		{types.NewQueryProposalsParams(query.NewPageRequest(nil, 0, 50, false), types.StatusRejected, nil, nil), 0},
+		{types.NewQueryProposalsParams(query.NewPageRequest(nil, 0, 50, false), types.StatusNil, addr1, nil), 50},
+		{types.NewQueryProposalsParams(query.NewPageRequest(nil, 0, 50, false), types.StatusNil, nil, addr1), 50},
+		{types.NewQueryProposalsParams(query.NewPageRequest(nil, 0, 50, false), types.StatusNil, addr1, addr1), 50},
+		{types.NewQueryProposalsParams(query.NewPageRequest(nil, 0, 50, false), types.StatusDepositPeriod, addr1, addr1), 25},
+		{types.NewQueryProposalsParams(query.NewPageRequest(nil, 0, 50, false), types.StatusDepositPeriod, nil, nil), 50},
+		{types.NewQueryProposalsParams(query.NewPageRequest(nil, 0, 50, false), types.StatusVotingPeriod, nil, nil), 50},
 	}
 
 	for _, tc := range testCases {
-		proposals := keeper.GetProposalsFiltered(ctx, tc.params)
+		proposals, _, err := keeper.GetProposalsFiltered(ctx, tc.params)
+		require.NoError(t, err)
 		require.Len(t, proposals, tc.expectedNumResults)
 
 		for _, p := range proposals {
@@ -3,10 +3,11 @@ package keeper
 import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
-	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
@@ -193,19 +194,18 @@ func queryVotes(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Ke
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
 	}
 
-	votes := keeper.GetVotes(ctx, params.ProposalID)
-	if votes == nil {
-		votes = types.Votes{}
-	} else {
-		start, end := client.Paginate(len(votes), params.Page, params.Limit, 100)
-		if start < 0 || end < 0 {
-			votes = types.Votes{}
-		} else {
-			votes = votes[start:end]
-		}
+	votes := types.Votes{}
+	store := prefix.NewStore(ctx.KVStore(keeper.storeKey), types.VotesKey(params.ProposalID))
+
+	pageRes, err := query.Paginate(store, params.Pagination, func(_, value []byte) error {
+		votes = append(votes, keeper.mustUnmarshalVote(value))
+		return nil
+	})
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
 
-	bz, err := codec.MarshalJSONIndent(keeper.cdc, votes)
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, types.NewQueryVotesResponse(votes, pageRes))
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
 	}
@@ -220,12 +220,12 @@ func queryProposals(ctx sdk.Context, _ []string, req abci.RequestQuery, keeper K
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
 	}
 
-	proposals := keeper.GetProposalsFiltered(ctx, params)
-	if proposals == nil {
-		proposals = types.Proposals{}
+	proposals, pageRes, err := keeper.GetProposalsFiltered(ctx, params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
 
-	bz, err := codec.MarshalJSONIndent(keeper.cdc, proposals)
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, types.NewQueryProposalsResponse(proposals, pageRes))
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
 	}
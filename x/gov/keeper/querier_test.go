@@ -11,6 +11,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
@@ -58,22 +59,22 @@ func getQueriedParams(t *testing.T, ctx sdk.Context, cdc *codec.Codec, querier s
 
 func getQueriedProposals(
 	t *testing.T, ctx sdk.Context, cdc *codec.Codec, querier sdk.Querier,
-	depositor, voter sdk.AccAddress, status types.ProposalStatus, page, limit int,
+	depositor, voter sdk.AccAddress, status types.ProposalStatus, pageReq *query.PageRequest,
 ) []types.Proposal {
 
-	query := abci.RequestQuery{
+	req := abci.RequestQuery{
 		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryProposals}, "/"),
-		Data: cdc.MustMarshalJSON(types.NewQueryProposalsParams(page, limit, status, voter, depositor)),
+		Data: cdc.MustMarshalJSON(types.NewQueryProposalsParams(pageReq, status, voter, depositor)),
 	}
 
-	bz, err := querier(ctx, []string{types.QueryProposals}, query)
+	bz, err := querier(ctx, []string{types.QueryProposals}, req)
 	require.NoError(t, err)
 	require.NotNil(t, bz)
 
-	var proposals types.Proposals
-	require.NoError(t, cdc.UnmarshalJSON(bz, &proposals))
+	var res types.QueryProposalsResponse
+	require.NoError(t, cdc.UnmarshalJSON(bz, &res))
 
-	return proposals
+	return res.Proposals
 }
 
 func getQueriedDeposit(t *testing.T, ctx sdk.Context, cdc *codec.Codec, querier sdk.Querier, proposalID uint64, depositor sdk.AccAddress) types.Deposit {
@@ -125,20 +126,20 @@ func getQueriedVote(t *testing.T, ctx sdk.Context, cdc *codec.Codec, querier sdk
 }
 
 func getQueriedVotes(t *testing.T, ctx sdk.Context, cdc *codec.Codec, querier sdk.Querier,
-	proposalID uint64, page, limit int) []types.Vote {
-	query := abci.RequestQuery{
+	proposalID uint64, pageReq *query.PageRequest) types.QueryVotesResponse {
+	req := abci.RequestQuery{
 		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryVote}, "/"),
-		Data: cdc.MustMarshalJSON(types.NewQueryProposalVotesParams(proposalID, page, limit)),
+		Data: cdc.MustMarshalJSON(types.NewQueryProposalVotesParams(proposalID, pageReq)),
 	}
 
-	bz, err := querier(ctx, []string{types.QueryVotes}, query)
+	bz, err := querier(ctx, []string{types.QueryVotes}, req)
 	require.NoError(t, err)
 	require.NotNil(t, bz)
 
-	var votes []types.Vote
-	require.NoError(t, cdc.UnmarshalJSON(bz, &votes))
+	var res types.QueryVotesResponse
+	require.NoError(t, cdc.UnmarshalJSON(bz, &res))
 
-	return votes
+	return res
 }
 
 func TestQueries(t *testing.T) {
@@ -221,33 +222,33 @@ func TestQueries(t *testing.T) {
 	require.Equal(t, deposit5, deposit)
 
 	// Only proposal #1 should be in types.Deposit Period
-	proposals := getQueriedProposals(t, ctx, keeper.cdc, querier, nil, nil, types.StatusDepositPeriod, 1, 0)
+	proposals := getQueriedProposals(t, ctx, keeper.cdc, querier, nil, nil, types.StatusDepositPeriod, nil)
 	require.Len(t, proposals, 1)
 	require.Equal(t, proposal1, proposals[0])
 
 	// Only proposals #2 and #3 should be in Voting Period
-	proposals = getQueriedProposals(t, ctx, keeper.cdc, querier, nil, nil, types.StatusVotingPeriod, 1, 0)
+	proposals = getQueriedProposals(t, ctx, keeper.cdc, querier, nil, nil, types.StatusVotingPeriod, nil)
 	require.Len(t, proposals, 2)
 	require.Equal(t, proposal2, proposals[0])
 	require.Equal(t, proposal3, proposals[1])
 
 	// Addrs[0] votes on proposals #2 & #3
-	vote1 := types.NewVote(proposal2.ProposalID, TestAddrs[0], types.OptionYes)
-	vote2 := types.NewVote(proposal3.ProposalID, TestAddrs[0], types.OptionYes)
+	vote1 := types.NewVote(proposal2.ProposalID, TestAddrs[0], types.NewNonSplitVoteOption(types.OptionYes))
+	vote2 := types.NewVote(proposal3.ProposalID, TestAddrs[0], types.NewNonSplitVoteOption(types.OptionYes))
 	keeper.SetVote(ctx, vote1)
 	keeper.SetVote(ctx, vote2)
 
 	// Addrs[1] votes on proposal #3
-	vote3 := types.NewVote(proposal3.ProposalID, TestAddrs[1], types.OptionYes)
+	vote3 := types.NewVote(proposal3.ProposalID, TestAddrs[1], types.NewNonSplitVoteOption(types.OptionYes))
 	keeper.SetVote(ctx, vote3)
 
 	// Test query voted by TestAddrs[0]
-	proposals = getQueriedProposals(t, ctx, keeper.cdc, querier, nil, TestAddrs[0], types.StatusNil, 1, 0)
+	proposals = getQueriedProposals(t, ctx, keeper.cdc, querier, nil, TestAddrs[0], types.StatusNil, nil)
 	require.Equal(t, proposal2, proposals[0])
 	require.Equal(t, proposal3, proposals[1])
 
 	// Test query votes on types.Proposal 2
-	votes := getQueriedVotes(t, ctx, keeper.cdc, querier, proposal2.ProposalID, 1, 0)
+	votes := getQueriedVotes(t, ctx, keeper.cdc, querier, proposal2.ProposalID, nil).Votes
 	require.Len(t, votes, 1)
 	require.Equal(t, vote1, votes[0])
 
@@ -255,32 +256,32 @@ func TestQueries(t *testing.T) {
 	require.Equal(t, vote1, vote)
 
 	// Test query votes on types.Proposal 3
-	votes = getQueriedVotes(t, ctx, keeper.cdc, querier, proposal3.ProposalID, 1, 0)
+	votes = getQueriedVotes(t, ctx, keeper.cdc, querier, proposal3.ProposalID, nil).Votes
 	require.Len(t, votes, 2)
 	require.Equal(t, vote2, votes[0])
 	require.Equal(t, vote3, votes[1])
 
 	// Test query all proposals
-	proposals = getQueriedProposals(t, ctx, keeper.cdc, querier, nil, nil, types.StatusNil, 1, 0)
+	proposals = getQueriedProposals(t, ctx, keeper.cdc, querier, nil, nil, types.StatusNil, nil)
 	require.Equal(t, proposal1, proposals[0])
 	require.Equal(t, proposal2, proposals[1])
 	require.Equal(t, proposal3, proposals[2])
 
 	// Test query voted by TestAddrs[1]
-	proposals = getQueriedProposals(t, ctx, keeper.cdc, querier, nil, TestAddrs[1], types.StatusNil, 1, 0)
+	proposals = getQueriedProposals(t, ctx, keeper.cdc, querier, nil, TestAddrs[1], types.StatusNil, nil)
 	require.Equal(t, proposal3.ProposalID, proposals[0].ProposalID)
 
 	// Test query deposited by TestAddrs[0]
-	proposals = getQueriedProposals(t, ctx, keeper.cdc, querier, TestAddrs[0], nil, types.StatusNil, 1, 0)
+	proposals = getQueriedProposals(t, ctx, keeper.cdc, querier, TestAddrs[0], nil, types.StatusNil, nil)
 	require.Equal(t, proposal1.ProposalID, proposals[0].ProposalID)
 
 	// Test query deposited by addr2
-	proposals = getQueriedProposals(t, ctx, keeper.cdc, querier, TestAddrs[1], nil, types.StatusNil, 1, 0)
+	proposals = getQueriedProposals(t, ctx, keeper.cdc, querier, TestAddrs[1], nil, types.StatusNil, nil)
 	require.Equal(t, proposal2.ProposalID, proposals[0].ProposalID)
 	require.Equal(t, proposal3.ProposalID, proposals[1].ProposalID)
 
 	// Test query voted AND deposited by addr1
-	proposals = getQueriedProposals(t, ctx, keeper.cdc, querier, TestAddrs[0], TestAddrs[0], types.StatusNil, 1, 0)
+	proposals = getQueriedProposals(t, ctx, keeper.cdc, querier, TestAddrs[0], TestAddrs[0], types.StatusNil, nil)
 	require.Equal(t, proposal2.ProposalID, proposals[0].ProposalID)
 }
 
@@ -301,7 +302,7 @@ func TestPaginatedVotesQuery(t *testing.T) {
 		vote := types.Vote{
 			ProposalID: proposal.ProposalID,
 			Voter:      addr,
-			Option:     types.OptionYes,
+			Options:    types.NewNonSplitVoteOption(types.OptionYes),
 		}
 		votes[i] = vote
 		keeper.SetVote(ctx, vote)
@@ -310,41 +311,42 @@ func TestPaginatedVotesQuery(t *testing.T) {
 	querier := NewQuerier(keeper)
 
 	// keeper preserves consistent order for each query, but this is not the insertion order
-	all := getQueriedVotes(t, ctx, keeper.cdc, querier, proposal.ProposalID, 1, 0)
-	require.Equal(t, len(all), len(votes))
+	all := getQueriedVotes(t, ctx, keeper.cdc, querier, proposal.ProposalID, nil)
+	require.Equal(t, len(all.Votes), len(votes))
+	require.Equal(t, uint64(len(votes)), all.Pagination.Total)
+
+	firstChunk := getQueriedVotes(t, ctx, keeper.cdc, querier, proposal.ProposalID, query.NewPageRequest(nil, 0, 10, false))
+	require.NotNil(t, firstChunk.Pagination.NextKey)
 
 	type testCase struct {
 		description string
-		page        int
-		limit       int
+		pageReq     *query.PageRequest
 		votes       []types.Vote
 	}
 	for _, tc := range []testCase{
 		{
 			description: "SkipAll",
-			page:        2,
-			limit:       len(all),
+			pageReq:     query.NewPageRequest(nil, uint64(len(all.Votes)), uint64(len(all.Votes)), false),
 		},
 		{
 			description: "GetFirstChunk",
-			page:        1,
-			limit:       10,
-			votes:       all[:10],
+			pageReq:     query.NewPageRequest(nil, 0, 10, false),
+			votes:       all.Votes[:10],
 		},
 		{
 			description: "GetSecondsChunk",
-			page:        2,
-			limit:       10,
-			votes:       all[10:],
+			pageReq:     query.NewPageRequest(nil, 10, 10, false),
+			votes:       all.Votes[10:],
 		},
 		{
-			description: "InvalidPage",
-			page:        -1,
+			description: "GetSecondChunkByKey",
+			pageReq:     query.NewPageRequest(firstChunk.Pagination.NextKey, 0, 10, false),
+			votes:       all.Votes[10:],
 		},
 	} {
 		tc := tc
 		t.Run(tc.description, func(t *testing.T) {
-			votes := getQueriedVotes(t, ctx, keeper.cdc, querier, proposal.ProposalID, tc.page, tc.limit)
+			votes := getQueriedVotes(t, ctx, keeper.cdc, querier, proposal.ProposalID, tc.pageReq).Votes
 			require.Equal(t, len(tc.votes), len(votes))
 			for i := range votes {
 				require.Equal(t, tc.votes[i], votes[i])
@@ -41,7 +41,7 @@ func TestTallyNoQuorum(t *testing.T) {
 	proposal.Status = types.StatusVotingPeriod
 	keeper.SetProposal(ctx, proposal)
 
-	err = keeper.AddVote(ctx, proposalID, TestAddrs[0], types.OptionYes)
+	err = keeper.AddVote(ctx, proposalID, TestAddrs[0], types.NewNonSplitVoteOption(types.OptionYes))
 	require.Nil(t, err)
 
 	proposal, ok := keeper.GetProposal(ctx, proposalID)
@@ -62,9 +62,9 @@ func TestTallyOnlyValidatorsAllYes(t *testing.T) {
 	proposal.Status = types.StatusVotingPeriod
 	keeper.SetProposal(ctx, proposal)
 
-	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr1, types.OptionYes))
-	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr2, types.OptionYes))
-	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr3, types.OptionYes))
+	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr1, types.NewNonSplitVoteOption(types.OptionYes)))
+	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr2, types.NewNonSplitVoteOption(types.OptionYes)))
+	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr3, types.NewNonSplitVoteOption(types.OptionYes)))
 
 	proposal, ok := keeper.GetProposal(ctx, proposalID)
 	require.True(t, ok)
@@ -86,8 +86,8 @@ func TestTallyOnlyValidators51No(t *testing.T) {
 	proposal.Status = types.StatusVotingPeriod
 	keeper.SetProposal(ctx, proposal)
 
-	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr1, types.OptionYes))
-	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr2, types.OptionNo))
+	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr1, types.NewNonSplitVoteOption(types.OptionYes)))
+	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr2, types.NewNonSplitVoteOption(types.OptionNo)))
 
 	proposal, ok := keeper.GetProposal(ctx, proposalID)
 	require.True(t, ok)
@@ -108,8 +108,8 @@ func TestTallyOnlyValidators51Yes(t *testing.T) {
 	proposal.Status = types.StatusVotingPeriod
 	keeper.SetProposal(ctx, proposal)
 
-	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr1, types.OptionNo))
-	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr2, types.OptionYes))
+	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr1, types.NewNonSplitVoteOption(types.OptionNo)))
+	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr2, types.NewNonSplitVoteOption(types.OptionYes)))
 
 	proposal, ok := keeper.GetProposal(ctx, proposalID)
 	require.True(t, ok)
@@ -131,9 +131,9 @@ func TestTallyOnlyValidatorsVetoed(t *testing.T) {
 	proposal.Status = types.StatusVotingPeriod
 	keeper.SetProposal(ctx, proposal)
 
-	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr1, types.OptionYes))
-	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr2, types.OptionYes))
-	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr3, types.OptionNoWithVeto))
+	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr1, types.NewNonSplitVoteOption(types.OptionYes)))
+	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr2, types.NewNonSplitVoteOption(types.OptionYes)))
+	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr3, types.NewNonSplitVoteOption(types.OptionNoWithVeto)))
 
 	proposal, ok := keeper.GetProposal(ctx, proposalID)
 	require.True(t, ok)
@@ -156,9 +156,9 @@ func TestTallyOnlyValidatorsAbstainPasses(t *testing.T) {
 	proposal.Status = types.StatusVotingPeriod
 	keeper.SetProposal(ctx, proposal)
 
-	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr1, types.OptionAbstain))
-	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr2, types.OptionNo))
-	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr3, types.OptionYes))
+	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr1, types.NewNonSplitVoteOption(types.OptionAbstain)))
+	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr2, types.NewNonSplitVoteOption(types.OptionNo)))
+	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr3, types.NewNonSplitVoteOption(types.OptionYes)))
 
 	proposal, ok := keeper.GetProposal(ctx, proposalID)
 	require.True(t, ok)
@@ -180,9 +180,9 @@ func TestTallyOnlyValidatorsAbstainFails(t *testing.T) {
 	proposal.Status = types.StatusVotingPeriod
 	keeper.SetProposal(ctx, proposal)
 
-	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr1, types.OptionAbstain))
-	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr2, types.OptionYes))
-	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr3, types.OptionNo))
+	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr1, types.NewNonSplitVoteOption(types.OptionAbstain)))
+	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr2, types.NewNonSplitVoteOption(types.OptionYes)))
+	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr3, types.NewNonSplitVoteOption(types.OptionNo)))
 
 	proposal, ok := keeper.GetProposal(ctx, proposalID)
 	require.True(t, ok)
@@ -204,8 +204,8 @@ func TestTallyOnlyValidatorsNonVoter(t *testing.T) {
 	proposal.Status = types.StatusVotingPeriod
 	keeper.SetProposal(ctx, proposal)
 
-	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr1, types.OptionYes))
-	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr2, types.OptionNo))
+	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr1, types.NewNonSplitVoteOption(types.OptionYes)))
+	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr2, types.NewNonSplitVoteOption(types.OptionNo)))
 
 	proposal, ok := keeper.GetProposal(ctx, proposalID)
 	require.True(t, ok)
@@ -236,10 +236,10 @@ func TestTallyDelgatorOverride(t *testing.T) {
 	proposal.Status = types.StatusVotingPeriod
 	keeper.SetProposal(ctx, proposal)
 
-	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr1, types.OptionYes))
-	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr2, types.OptionYes))
-	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr3, types.OptionYes))
-	require.NoError(t, keeper.AddVote(ctx, proposalID, TestAddrs[0], types.OptionNo))
+	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr1, types.NewNonSplitVoteOption(types.OptionYes)))
+	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr2, types.NewNonSplitVoteOption(types.OptionYes)))
+	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr3, types.NewNonSplitVoteOption(types.OptionYes)))
+	require.NoError(t, keeper.AddVote(ctx, proposalID, TestAddrs[0], types.NewNonSplitVoteOption(types.OptionNo)))
 
 	proposal, ok := keeper.GetProposal(ctx, proposalID)
 	require.True(t, ok)
@@ -270,9 +270,9 @@ func TestTallyDelgatorInherit(t *testing.T) {
 	proposal.Status = types.StatusVotingPeriod
 	keeper.SetProposal(ctx, proposal)
 
-	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr1, types.OptionNo))
-	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr2, types.OptionNo))
-	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr3, types.OptionYes))
+	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr1, types.NewNonSplitVoteOption(types.OptionNo)))
+	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr2, types.NewNonSplitVoteOption(types.OptionNo)))
+	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr3, types.NewNonSplitVoteOption(types.OptionYes)))
 
 	proposal, ok := keeper.GetProposal(ctx, proposalID)
 	require.True(t, ok)
@@ -307,10 +307,10 @@ func TestTallyDelgatorMultipleOverride(t *testing.T) {
 	proposal.Status = types.StatusVotingPeriod
 	keeper.SetProposal(ctx, proposal)
 
-	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr1, types.OptionYes))
-	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr2, types.OptionYes))
-	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr3, types.OptionYes))
-	require.NoError(t, keeper.AddVote(ctx, proposalID, TestAddrs[0], types.OptionNo))
+	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr1, types.NewNonSplitVoteOption(types.OptionYes)))
+	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr2, types.NewNonSplitVoteOption(types.OptionYes)))
+	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr3, types.NewNonSplitVoteOption(types.OptionYes)))
+	require.NoError(t, keeper.AddVote(ctx, proposalID, TestAddrs[0], types.NewNonSplitVoteOption(types.OptionNo)))
 
 	proposal, ok := keeper.GetProposal(ctx, proposalID)
 	require.True(t, ok)
@@ -345,9 +345,9 @@ func TestTallyDelgatorMultipleInherit(t *testing.T) {
 	proposal.Status = types.StatusVotingPeriod
 	keeper.SetProposal(ctx, proposal)
 
-	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr1, types.OptionYes))
-	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr2, types.OptionNo))
-	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr3, types.OptionNo))
+	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr1, types.NewNonSplitVoteOption(types.OptionYes)))
+	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr2, types.NewNonSplitVoteOption(types.OptionNo)))
+	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr3, types.NewNonSplitVoteOption(types.OptionNo)))
 
 	proposal, ok := keeper.GetProposal(ctx, proposalID)
 	require.True(t, ok)
@@ -384,9 +384,9 @@ func TestTallyJailedValidator(t *testing.T) {
 	proposal.Status = types.StatusVotingPeriod
 	keeper.SetProposal(ctx, proposal)
 
-	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr1, types.OptionYes))
-	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr2, types.OptionNo))
-	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr3, types.OptionNo))
+	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr1, types.NewNonSplitVoteOption(types.OptionYes)))
+	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr2, types.NewNonSplitVoteOption(types.OptionNo)))
+	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr3, types.NewNonSplitVoteOption(types.OptionNo)))
 
 	proposal, ok := keeper.GetProposal(ctx, proposalID)
 	require.True(t, ok)
@@ -415,9 +415,9 @@ func TestTallyValidatorMultipleDelegations(t *testing.T) {
 	proposal.Status = types.StatusVotingPeriod
 	keeper.SetProposal(ctx, proposal)
 
-	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr1, types.OptionYes))
-	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr2, types.OptionNo))
-	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr3, types.OptionYes))
+	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr1, types.NewNonSplitVoteOption(types.OptionYes)))
+	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr2, types.NewNonSplitVoteOption(types.OptionNo)))
+	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr3, types.NewNonSplitVoteOption(types.OptionYes)))
 
 	proposal, ok := keeper.GetProposal(ctx, proposalID)
 	require.True(t, ok)
@@ -434,3 +434,80 @@ func TestTallyValidatorMultipleDelegations(t *testing.T) {
 
 	require.True(t, tallyResults.Equals(expectedTallyResult))
 }
+
+func TestTallyValidatorsSplitVote(t *testing.T) {
+	ctx, _, _, keeper, sk, _ := createTestInput(t, false, 100)
+	createValidators(ctx, sk, []int64{5, 5, 5})
+
+	tp := TestProposal
+	proposal, err := keeper.SubmitProposal(ctx, tp)
+	require.NoError(t, err)
+	proposalID := proposal.ProposalID
+	proposal.Status = types.StatusVotingPeriod
+	keeper.SetProposal(ctx, proposal)
+
+	splitVote := types.WeightedVoteOptions{
+		types.NewWeightedVoteOption(types.OptionYes, sdk.NewDecWithPrec(5, 1)),
+		types.NewWeightedVoteOption(types.OptionNo, sdk.NewDecWithPrec(5, 1)),
+	}
+	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr1, splitVote))
+	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr2, types.NewNonSplitVoteOption(types.OptionYes)))
+	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr3, types.NewNonSplitVoteOption(types.OptionAbstain)))
+
+	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	passes, burnDeposits, tallyResults := keeper.Tally(ctx, proposal)
+
+	valTokens := sdk.TokensFromConsensusPower(5)
+	require.True(t, passes)
+	require.False(t, burnDeposits)
+	require.Equal(t, types.TallyResult{
+		Yes:        valTokens.QuoRaw(2).Add(valTokens),
+		Abstain:    valTokens,
+		No:         valTokens.QuoRaw(2),
+		NoWithVeto: sdk.ZeroInt(),
+	}, tallyResults)
+}
+
+func TestTallyDelegatorSplitVoteOverride(t *testing.T) {
+	ctx, _, _, keeper, sk, _ := createTestInput(t, false, 100)
+	createValidators(ctx, sk, []int64{5, 6, 7})
+
+	delTokens := sdk.TokensFromConsensusPower(30)
+	val1, found := sk.GetValidator(ctx, valOpAddr1)
+	require.True(t, found)
+
+	_, err := sk.Delegate(ctx, TestAddrs[0], delTokens, sdk.Unbonded, val1, true)
+	require.NoError(t, err)
+
+	_ = staking.EndBlocker(ctx, sk)
+
+	tp := TestProposal
+	proposal, err := keeper.SubmitProposal(ctx, tp)
+	require.NoError(t, err)
+	proposalID := proposal.ProposalID
+	proposal.Status = types.StatusVotingPeriod
+	keeper.SetProposal(ctx, proposal)
+
+	// the delegator splits its voting power, overriding the validator's yes vote
+	splitVote := types.WeightedVoteOptions{
+		types.NewWeightedVoteOption(types.OptionNo, sdk.NewDecWithPrec(8, 1)),
+		types.NewWeightedVoteOption(types.OptionNoWithVeto, sdk.NewDecWithPrec(2, 1)),
+	}
+	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr1, types.NewNonSplitVoteOption(types.OptionYes)))
+	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr2, types.NewNonSplitVoteOption(types.OptionYes)))
+	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr3, types.NewNonSplitVoteOption(types.OptionYes)))
+	require.NoError(t, keeper.AddVote(ctx, proposalID, TestAddrs[0], splitVote))
+
+	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	passes, burnDeposits, tallyResults := keeper.Tally(ctx, proposal)
+
+	// the delegator's 30 are split into 24 no and 6 no with veto, while the
+	// validators' own 18 are tallied as yes
+	require.False(t, passes)
+	require.False(t, burnDeposits)
+	require.Equal(t, sdk.TokensFromConsensusPower(24), tallyResults.No)
+	require.Equal(t, sdk.TokensFromConsensusPower(6), tallyResults.NoWithVeto)
+	require.True(t, tallyResults.Abstain.IsZero())
+}
@@ -9,7 +9,7 @@ import (
 )
 
 // AddVote adds a vote on a specific proposal
-func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, option types.VoteOption) error {
+func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, options types.WeightedVoteOptions) error {
 	proposal, ok := keeper.GetProposal(ctx, proposalID)
 	if !ok {
 		return sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
@@ -18,17 +18,17 @@ func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.A
 		return sdkerrors.Wrapf(types.ErrInactiveProposal, "%d", proposalID)
 	}
 
-	if !types.ValidVoteOption(option) {
-		return sdkerrors.Wrap(types.ErrInvalidVote, option.String())
+	if err := options.ValidateBasic(); err != nil {
+		return err
 	}
 
-	vote := types.NewVote(proposalID, voterAddr, option)
+	vote := types.NewVote(proposalID, voterAddr, options)
 	keeper.SetVote(ctx, vote)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeProposalVote,
-			sdk.NewAttribute(types.AttributeKeyOption, option.String()),
+			sdk.NewAttribute(types.AttributeKeyOption, options.String()),
 			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
 		),
 	)
@@ -62,8 +62,7 @@ func (keeper Keeper) GetVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.A
 		return vote, false
 	}
 
-	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &vote)
-	return vote, true
+	return keeper.mustUnmarshalVote(bz), true
 }
 
 // SetVote sets a Vote to the gov store
@@ -80,8 +79,7 @@ func (keeper Keeper) IterateAllVotes(ctx sdk.Context, cb func(vote types.Vote) (
 
 	defer iterator.Close()
 	for ; iterator.Valid(); iterator.Next() {
-		var vote types.Vote
-		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &vote)
+		vote := keeper.mustUnmarshalVote(iterator.Value())
 
 		if cb(vote) {
 			break
@@ -96,8 +94,7 @@ func (keeper Keeper) IterateVotes(ctx sdk.Context, proposalID uint64, cb func(vo
 
 	defer iterator.Close()
 	for ; iterator.Valid(); iterator.Next() {
-		var vote types.Vote
-		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &vote)
+		vote := keeper.mustUnmarshalVote(iterator.Value())
 
 		if cb(vote) {
 			break
@@ -105,6 +102,28 @@ func (keeper Keeper) IterateVotes(ctx sdk.Context, proposalID uint64, cb func(vo
 	}
 }
 
+// legacyVote is the encoding of a vote stored before weighted votes were
+// introduced, when every vote carried a single option.
+type legacyVote struct {
+	ProposalID uint64           `json:"proposal_id"`
+	Voter      sdk.AccAddress   `json:"voter"`
+	Option     types.VoteOption `json:"option"`
+}
+
+// mustUnmarshalVote decodes a stored vote. Votes stored with a single option
+// are converted to a non-split weighted vote so that chains upgraded in place
+// can still read, tally and query them.
+func (keeper Keeper) mustUnmarshalVote(bz []byte) types.Vote {
+	var vote types.Vote
+	if err := keeper.cdc.UnmarshalBinaryLengthPrefixed(bz, &vote); err == nil {
+		return vote
+	}
+
+	var old legacyVote
+	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &old)
+	return types.NewVote(old.ProposalID, old.Voter, types.NewNonSplitVoteOption(old.Option))
+}
+
 // deleteVote deletes a vote from a given proposalID and voter from the store
 func (keeper Keeper) deleteVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress) {
 	store := ctx.KVStore(keeper.storeKey)
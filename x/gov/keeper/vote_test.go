@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
@@ -18,37 +19,37 @@ func TestVotes(t *testing.T) {
 
 	var invalidOption types.VoteOption = 0x10
 
-	require.Error(t, keeper.AddVote(ctx, proposalID, TestAddrs[0], types.OptionYes), "proposal not on voting period")
-	require.Error(t, keeper.AddVote(ctx, 10, TestAddrs[0], types.OptionYes), "invalid proposal ID")
+	require.Error(t, keeper.AddVote(ctx, proposalID, TestAddrs[0], types.NewNonSplitVoteOption(types.OptionYes)), "proposal not on voting period")
+	require.Error(t, keeper.AddVote(ctx, 10, TestAddrs[0], types.NewNonSplitVoteOption(types.OptionYes)), "invalid proposal ID")
 
 	proposal.Status = types.StatusVotingPeriod
 	keeper.SetProposal(ctx, proposal)
 
-	require.Error(t, keeper.AddVote(ctx, proposalID, TestAddrs[0], invalidOption), "invalid option")
+	require.Error(t, keeper.AddVote(ctx, proposalID, TestAddrs[0], types.NewNonSplitVoteOption(invalidOption)), "invalid option")
 
 	// Test first vote
-	require.NoError(t, keeper.AddVote(ctx, proposalID, TestAddrs[0], types.OptionAbstain))
+	require.NoError(t, keeper.AddVote(ctx, proposalID, TestAddrs[0], types.NewNonSplitVoteOption(types.OptionAbstain)))
 	vote, found := keeper.GetVote(ctx, proposalID, TestAddrs[0])
 	require.True(t, found)
 	require.Equal(t, TestAddrs[0], vote.Voter)
 	require.Equal(t, proposalID, vote.ProposalID)
-	require.Equal(t, types.OptionAbstain, vote.Option)
+	require.True(t, vote.Options.Equals(types.NewNonSplitVoteOption(types.OptionAbstain)))
 
 	// Test change of vote
-	require.NoError(t, keeper.AddVote(ctx, proposalID, TestAddrs[0], types.OptionYes))
+	require.NoError(t, keeper.AddVote(ctx, proposalID, TestAddrs[0], types.NewNonSplitVoteOption(types.OptionYes)))
 	vote, found = keeper.GetVote(ctx, proposalID, TestAddrs[0])
 	require.True(t, found)
 	require.Equal(t, TestAddrs[0], vote.Voter)
 	require.Equal(t, proposalID, vote.ProposalID)
-	require.Equal(t, types.OptionYes, vote.Option)
+	require.True(t, vote.Options.Equals(types.NewNonSplitVoteOption(types.OptionYes)))
 
 	// Test second vote
-	require.NoError(t, keeper.AddVote(ctx, proposalID, TestAddrs[1], types.OptionNoWithVeto))
+	require.NoError(t, keeper.AddVote(ctx, proposalID, TestAddrs[1], types.NewNonSplitVoteOption(types.OptionNoWithVeto)))
 	vote, found = keeper.GetVote(ctx, proposalID, TestAddrs[1])
 	require.True(t, found)
 	require.Equal(t, TestAddrs[1], vote.Voter)
 	require.Equal(t, proposalID, vote.ProposalID)
-	require.Equal(t, types.OptionNoWithVeto, vote.Option)
+	require.True(t, vote.Options.Equals(types.NewNonSplitVoteOption(types.OptionNoWithVeto)))
 
 	// Test vote iterator
 	// NOTE order of deposits is determined by the addresses
@@ -57,8 +58,61 @@ func TestVotes(t *testing.T) {
 	require.Equal(t, votes, keeper.GetVotes(ctx, proposalID))
 	require.Equal(t, TestAddrs[0], votes[0].Voter)
 	require.Equal(t, proposalID, votes[0].ProposalID)
-	require.Equal(t, types.OptionYes, votes[0].Option)
+	require.True(t, votes[0].Options.Equals(types.NewNonSplitVoteOption(types.OptionYes)))
 	require.Equal(t, TestAddrs[1], votes[1].Voter)
 	require.Equal(t, proposalID, votes[1].ProposalID)
-	require.Equal(t, types.OptionNoWithVeto, votes[1].Option)
+	require.True(t, votes[1].Options.Equals(types.NewNonSplitVoteOption(types.OptionNoWithVeto)))
+}
+
+func TestWeightedVotes(t *testing.T) {
+	ctx, _, _, keeper, _, _ := createTestInput(t, false, 100) // nolint: dogsled
+
+	tp := TestProposal
+	proposal, err := keeper.SubmitProposal(ctx, tp)
+	require.NoError(t, err)
+	proposalID := proposal.ProposalID
+	proposal.Status = types.StatusVotingPeriod
+	keeper.SetProposal(ctx, proposal)
+
+	invalidOptions := types.WeightedVoteOptions{
+		types.NewWeightedVoteOption(types.OptionYes, sdk.NewDecWithPrec(5, 1)),
+		types.NewWeightedVoteOption(types.OptionNo, sdk.NewDecWithPrec(4, 1)),
+	}
+	require.Error(t, keeper.AddVote(ctx, proposalID, TestAddrs[0], invalidOptions), "weights do not add up to 1")
+
+	options := types.WeightedVoteOptions{
+		types.NewWeightedVoteOption(types.OptionYes, sdk.NewDecWithPrec(6, 1)),
+		types.NewWeightedVoteOption(types.OptionNo, sdk.NewDecWithPrec(3, 1)),
+		types.NewWeightedVoteOption(types.OptionAbstain, sdk.NewDecWithPrec(1, 1)),
+	}
+	require.NoError(t, keeper.AddVote(ctx, proposalID, TestAddrs[0], options))
+	vote, found := keeper.GetVote(ctx, proposalID, TestAddrs[0])
+	require.True(t, found)
+	require.Equal(t, TestAddrs[0], vote.Voter)
+	require.Equal(t, proposalID, vote.ProposalID)
+	require.True(t, vote.Options.Equals(options))
+}
+
+func TestLegacyVotes(t *testing.T) {
+	ctx, _, _, keeper, _, _ := createTestInput(t, false, 100) // nolint: dogsled
+
+	proposal, err := keeper.SubmitProposal(ctx, TestProposal)
+	require.NoError(t, err)
+	proposalID := proposal.ProposalID
+
+	// store a vote with the single option encoding used before weighted votes
+	old := legacyVote{ProposalID: proposalID, Voter: TestAddrs[0], Option: types.OptionNo}
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.VoteKey(proposalID, TestAddrs[0]), keeper.cdc.MustMarshalBinaryLengthPrefixed(old))
+
+	vote, found := keeper.GetVote(ctx, proposalID, TestAddrs[0])
+	require.True(t, found)
+	require.Equal(t, TestAddrs[0], vote.Voter)
+	require.Equal(t, proposalID, vote.ProposalID)
+	require.True(t, vote.Options.Equals(types.NewNonSplitVoteOption(types.OptionNo)))
+
+	votes := keeper.GetVotes(ctx, proposalID)
+	require.Len(t, votes, 1)
+	require.Equal(t, vote, votes[0])
+	require.Equal(t, votes, keeper.GetAllVotes(ctx))
 }
@@ -0,0 +1,21 @@
+package v039
+
+import (
+	v034gov "github.com/cosmos/cosmos-sdk/x/gov/legacy/v0_34"
+)
+
+// Migrate accepts the exported votes of the x/gov genesis state from v0.38 and
+// migrates them to v0.39 weighted votes, where every vote carries its option
+// with the entire voting power. The remaining x/gov genesis state is unchanged.
+func Migrate(oldVotes v034gov.Votes) Votes {
+	votes := make(Votes, len(oldVotes))
+	for i, vote := range oldVotes {
+		votes[i] = Vote{
+			ProposalID: vote.ProposalID,
+			Voter:      vote.Voter,
+			Options:    NewNonSplitVoteOption(vote.Option),
+		}
+	}
+
+	return votes
+}
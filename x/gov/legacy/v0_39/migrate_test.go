@@ -0,0 +1,50 @@
+package v039_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v034gov "github.com/cosmos/cosmos-sdk/x/gov/legacy/v0_34"
+	v039gov "github.com/cosmos/cosmos-sdk/x/gov/legacy/v0_39"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrate(t *testing.T) {
+	v039Codec := codec.New()
+
+	voter, _ := sdk.AccAddressFromBech32("cosmos1xxkueklal9vejv9unqu80w9vptyepfa95pd53u")
+	votes := v034gov.Votes{
+		{ProposalID: 1, Voter: voter, Option: v034gov.OptionYes},
+		{ProposalID: 2, Voter: voter, Option: v034gov.OptionNoWithVeto},
+	}
+
+	migrated := v039gov.Migrate(votes)
+	expected := `[
+  {
+    "proposal_id": "1",
+    "voter": "cosmos1xxkueklal9vejv9unqu80w9vptyepfa95pd53u",
+    "options": [
+      {
+        "option": "Yes",
+        "weight": "1.000000000000000000"
+      }
+    ]
+  },
+  {
+    "proposal_id": "2",
+    "voter": "cosmos1xxkueklal9vejv9unqu80w9vptyepfa95pd53u",
+    "options": [
+      {
+        "option": "NoWithVeto",
+        "weight": "1.000000000000000000"
+      }
+    ]
+  }
+]`
+
+	bz, err := v039Codec.MarshalJSONIndent(migrated, "", "  ")
+	require.NoError(t, err)
+	require.Equal(t, expected, string(bz))
+}
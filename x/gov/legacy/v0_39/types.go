@@ -0,0 +1,34 @@
+package v039
+
+// DONTCOVER
+// nolint
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v034gov "github.com/cosmos/cosmos-sdk/x/gov/legacy/v0_34"
+)
+
+const (
+	ModuleName = "gov"
+)
+
+type (
+	WeightedVoteOption struct {
+		Option v034gov.VoteOption `json:"option" yaml:"option"`
+		Weight sdk.Dec            `json:"weight" yaml:"weight"`
+	}
+
+	WeightedVoteOptions []WeightedVoteOption
+
+	Vote struct {
+		ProposalID uint64              `json:"proposal_id" yaml:"proposal_id"`
+		Voter      sdk.AccAddress      `json:"voter" yaml:"voter"`
+		Options    WeightedVoteOptions `json:"options" yaml:"options"`
+	}
+
+	Votes []Vote
+)
+
+func NewNonSplitVoteOption(option v034gov.VoteOption) WeightedVoteOptions {
+	return WeightedVoteOptions{{option, sdk.OneDec()}}
+}
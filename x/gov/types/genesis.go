@@ -69,5 +69,11 @@ func ValidateGenesis(data GenesisState) error {
 			data.DepositParams.MinDeposit.String())
 	}
 
+	for _, vote := range data.Votes {
+		if err := vote.Options.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid vote of %s on proposal %d: %w", vote.Voter, vote.ProposalID, err)
+		}
+	}
+
 	return nil
 }
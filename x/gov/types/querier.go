@@ -2,6 +2,7 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 // DONTCOVER
@@ -40,16 +41,28 @@ func NewQueryProposalParams(proposalID uint64) QueryProposalParams {
 // QueryProposalVotesParams used for queries to 'custom/gov/votes'.
 type QueryProposalVotesParams struct {
 	ProposalID uint64
-	Page       int
-	Limit      int
+	Pagination *query.PageRequest
 }
 
 // NewQueryProposalVotesParams creates new instance of the QueryProposalVotesParams.
-func NewQueryProposalVotesParams(proposalID uint64, page, limit int) QueryProposalVotesParams {
+func NewQueryProposalVotesParams(proposalID uint64, pageReq *query.PageRequest) QueryProposalVotesParams {
 	return QueryProposalVotesParams{
 		ProposalID: proposalID,
-		Page:       page,
-		Limit:      limit,
+		Pagination: pageReq,
+	}
+}
+
+// QueryVotesResponse is the response type of the 'custom/gov/votes' query.
+type QueryVotesResponse struct {
+	Votes      Votes               `json:"votes"`
+	Pagination *query.PageResponse `json:"pagination"`
+}
+
+// NewQueryVotesResponse creates a new instance of QueryVotesResponse.
+func NewQueryVotesResponse(votes Votes, pageRes *query.PageResponse) QueryVotesResponse {
+	return QueryVotesResponse{
+		Votes:      votes,
+		Pagination: pageRes,
 	}
 }
 
@@ -83,20 +96,35 @@ func NewQueryVoteParams(proposalID uint64, voter sdk.AccAddress) QueryVoteParams
 
 // QueryProposalsParams Params for query 'custom/gov/proposals'
 type QueryProposalsParams struct {
-	Page           int
-	Limit          int
+	Pagination     *query.PageRequest
 	Voter          sdk.AccAddress
 	Depositor      sdk.AccAddress
 	ProposalStatus ProposalStatus
 }
 
 // NewQueryProposalsParams creates a new instance of QueryProposalsParams
-func NewQueryProposalsParams(page, limit int, status ProposalStatus, voter, depositor sdk.AccAddress) QueryProposalsParams {
+func NewQueryProposalsParams(
+	pageReq *query.PageRequest, status ProposalStatus, voter, depositor sdk.AccAddress,
+) QueryProposalsParams {
+
 	return QueryProposalsParams{
-		Page:           page,
-		Limit:          limit,
+		Pagination:     pageReq,
 		Voter:          voter,
 		Depositor:      depositor,
 		ProposalStatus: status,
 	}
 }
+
+// QueryProposalsResponse is the response type of the 'custom/gov/proposals' query.
+type QueryProposalsResponse struct {
+	Proposals  Proposals           `json:"proposals"`
+	Pagination *query.PageResponse `json:"pagination"`
+}
+
+// NewQueryProposalsResponse creates a new instance of QueryProposalsResponse.
+func NewQueryProposalsResponse(proposals Proposals, pageRes *query.PageResponse) QueryProposalsResponse {
+	return QueryProposalsResponse{
+		Proposals:  proposals,
+		Pagination: pageRes,
+	}
+}
@@ -3,24 +3,26 @@ package types
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // Vote
 type Vote struct {
-	ProposalID uint64         `json:"proposal_id" yaml:"proposal_id"` //  proposalID of the proposal
-	Voter      sdk.AccAddress `json:"voter" yaml:"voter"`             //  address of the voter
-	Option     VoteOption     `json:"option" yaml:"option"`           //  option from OptionSet chosen by the voter
+	ProposalID uint64              `json:"proposal_id" yaml:"proposal_id"` //  proposalID of the proposal
+	Voter      sdk.AccAddress      `json:"voter" yaml:"voter"`             //  address of the voter
+	Options    WeightedVoteOptions `json:"options" yaml:"options"`         //  weighted options from OptionSet chosen by the voter
 }
 
 // NewVote creates a new Vote instance
-func NewVote(proposalID uint64, voter sdk.AccAddress, option VoteOption) Vote {
-	return Vote{proposalID, voter, option}
+func NewVote(proposalID uint64, voter sdk.AccAddress, options WeightedVoteOptions) Vote {
+	return Vote{proposalID, voter, options}
 }
 
 func (v Vote) String() string {
-	return fmt.Sprintf("voter %s voted with option %s on proposal %d", v.Voter, v.Option, v.ProposalID)
+	return fmt.Sprintf("voter %s voted with options %s on proposal %d", v.Voter, v.Options, v.ProposalID)
 }
 
 // Votes is a collection of Vote objects
@@ -32,7 +34,7 @@ func (v Votes) String() string {
 	}
 	out := fmt.Sprintf("Votes for Proposal %d:", v[0].ProposalID)
 	for _, vot := range v {
-		out += fmt.Sprintf("\n  %s: %s", vot.Voter, vot.Option)
+		out += fmt.Sprintf("\n  %s: %s", vot.Voter, vot.Options)
 	}
 	return out
 }
@@ -41,7 +43,7 @@ func (v Votes) String() string {
 func (v Vote) Equals(comp Vote) bool {
 	return v.Voter.Equals(comp.Voter) &&
 		v.ProposalID == comp.ProposalID &&
-		v.Option == comp.Option
+		v.Options.Equals(comp.Options)
 }
 
 // Empty returns whether a vote is empty.
@@ -49,6 +51,118 @@ func (v Vote) Empty() bool {
 	return v.Equals(Vote{})
 }
 
+// WeightedVoteOption defines a vote option along with the fraction of the
+// voting power it carries.
+type WeightedVoteOption struct {
+	Option VoteOption `json:"option" yaml:"option"`
+	Weight sdk.Dec    `json:"weight" yaml:"weight"`
+}
+
+// NewWeightedVoteOption creates a new WeightedVoteOption instance
+func NewWeightedVoteOption(option VoteOption, weight sdk.Dec) WeightedVoteOption {
+	return WeightedVoteOption{option, weight}
+}
+
+func (w WeightedVoteOption) String() string {
+	return fmt.Sprintf("%s=%s", w.Option, w.Weight)
+}
+
+// WeightedVoteOptions describes the split of a voter's voting power across
+// vote options.
+type WeightedVoteOptions []WeightedVoteOption
+
+// NewNonSplitVoteOption returns the WeightedVoteOptions of a single option
+// carrying the entire voting power.
+func NewNonSplitVoteOption(option VoteOption) WeightedVoteOptions {
+	return WeightedVoteOptions{{option, sdk.OneDec()}}
+}
+
+func (v WeightedVoteOptions) String() string {
+	out := make([]string, len(v))
+	for i, w := range v {
+		out[i] = w.String()
+	}
+	return strings.Join(out, ",")
+}
+
+// Equals returns whether two sets of weighted vote options are equal.
+func (v WeightedVoteOptions) Equals(comp WeightedVoteOptions) bool {
+	if len(v) != len(comp) {
+		return false
+	}
+
+	for i := range v {
+		if v[i].Option != comp[i].Option || !v[i].Weight.Equal(comp[i].Weight) {
+			return false
+		}
+	}
+	return true
+}
+
+// ValidateBasic checks that each option is valid and carries a positive
+// weight, that no option is repeated and that the weights add up to 1.
+func (v WeightedVoteOptions) ValidateBasic() error {
+	if len(v) == 0 {
+		return sdkerrors.Wrap(ErrInvalidVote, "no vote options")
+	}
+
+	usedOptions := make(map[VoteOption]bool)
+	totalWeight := sdk.ZeroDec()
+	for _, w := range v {
+		if !ValidWeightedVoteOption(w) {
+			return sdkerrors.Wrap(ErrInvalidVote, w.String())
+		}
+		if usedOptions[w.Option] {
+			return sdkerrors.Wrapf(ErrInvalidVote, "duplicated vote option %s", w.Option)
+		}
+
+		usedOptions[w.Option] = true
+		totalWeight = totalWeight.Add(w.Weight)
+	}
+
+	if !totalWeight.Equal(sdk.OneDec()) {
+		return sdkerrors.Wrapf(ErrInvalidVote, "total weight of vote options must be 1, got %s", totalWeight)
+	}
+
+	return nil
+}
+
+// WeightedVoteOptionsFromString returns WeightedVoteOptions from a
+// comma-separated list of option=weight pairs, e.g. "Yes=0.6,No=0.4". It
+// returns an error if any of the options or weights is invalid.
+func WeightedVoteOptionsFromString(str string) (WeightedVoteOptions, error) {
+	var options WeightedVoteOptions
+	for _, pair := range strings.Split(str, ",") {
+		fields := strings.Split(pair, "=")
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("'%s' is not a valid weighted vote option; expected option=weight", pair)
+		}
+
+		option, err := VoteOptionFromString(fields[0])
+		if err != nil {
+			return nil, err
+		}
+
+		weight, err := sdk.NewDecFromStr(fields[1])
+		if err != nil {
+			return nil, fmt.Errorf("'%s' is not a valid vote weight: %w", fields[1], err)
+		}
+
+		options = append(options, NewWeightedVoteOption(option, weight))
+	}
+
+	return options, nil
+}
+
+// ValidWeightedVoteOption returns true if the option is valid and its weight
+// is in the range (0, 1], and false otherwise.
+func ValidWeightedVoteOption(option WeightedVoteOption) bool {
+	if option.Weight.IsNil() || !option.Weight.IsPositive() || option.Weight.GT(sdk.OneDec()) {
+		return false
+	}
+	return ValidVoteOption(option.Option)
+}
+
 // VoteOption defines a vote option
 type VoteOption byte
 
@@ -9,12 +9,14 @@ type Config struct {
 	ExportParamsHeight int    //height to which export the randomly generated params
 	ExportStatePath    string //custom file path to save the exported app state JSON
 	ExportStatsPath    string // custom file path to save the exported simulation statistics JSON
+	ExportFailurePath  string // custom directory to save the app state, operation log and replay config of a failed simulation
 
 	Seed               int64  // simulation random seed
 	InitialBlockHeight int    // initial block to start the simulation
 	NumBlocks          int    // number of new blocks to simulate from the initial block height
 	BlockSize          int    // operations per block
 	ChainID            string // chain-id used on the simulation
+	ReplayHeight       int    // height of the replayed failure, after which a replayed simulation halts; 0 is no replay
 
 	Lean   bool // lean simulation log output
 	Commit bool // have the simulation commit
@@ -112,6 +112,28 @@ To export the simulation app state (i.e genesis) to a file:
 	-ExportStatePath=/path/to/genesis.json \
 	 v -timeout 24h
 
+To export the app state, the operation log and the replay config of a failed
+simulation to a directory:
+
+ $ go test -mod=readonly github.com/cosmos/cosmos-sdk/simapp \
+ 	-run=TestFullAppSimulation \
+ 	-Enabled=true \
+ 	-NumBlocks=100 \
+ 	-BlockSize=200 \
+ 	-Commit=true \
+ 	-Seed=99 \
+ 	-Period=5 \
+	-ExportFailurePath=/path/to/failure \
+	 -v -timeout 24h
+
+To replay the failed simulation up to the height of the failure:
+
+ $ go test -mod=readonly github.com/cosmos/cosmos-sdk/simapp \
+ 	-run=TestFullAppSimulation \
+ 	-Enabled=true \
+	-Replay=/path/to/failure/replay.json \
+	 -v -timeout 24h
+
 Params
 
 Params that are provided to simulation from a JSON file are used to used to set
@@ -0,0 +1,90 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// Files written to Config.ExportFailurePath when a simulation fails
+const (
+	FailureAppStateFile   = "app_state.json"
+	FailureOperationsFile = "operations.log"
+	FailureReplayFile     = "replay.json"
+)
+
+// ExportStateFn exports the application state as JSON
+type ExportStateFn func() (json.RawMessage, error)
+
+// exportFailure saves the app state as of the last committed block, the
+// operation log and the config needed to replay a simulation that failed at
+// the given height to config.ExportFailurePath.
+//
+// The app state is exported after the simulation failed, possibly from a panic
+// that left it corrupted, so a panic while exporting is returned as an error
+// rather than hiding the original failure.
+func exportFailure(
+	w io.Writer, config Config, height int64, exportStateFn ExportStateFn, logWriter LogWriter,
+) (err error) {
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic while exporting: %v", r)
+		}
+	}()
+
+	if err := os.MkdirAll(config.ExportFailurePath, os.ModePerm); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(w, "Exporting simulation failure at height %d to %s\n", height, config.ExportFailurePath)
+
+	if exportStateFn != nil {
+		appState, err := exportStateFn()
+		if err != nil {
+			return err
+		}
+
+		path := filepath.Join(config.ExportFailurePath, FailureAppStateFile)
+		if err := ioutil.WriteFile(path, appState, 0644); err != nil {
+			return err
+		}
+	}
+
+	if err := logWriter.ExportLogs(filepath.Join(config.ExportFailurePath, FailureOperationsFile)); err != nil {
+		return err
+	}
+
+	config.ReplayHeight = int(height)
+	bz, err := json.MarshalIndent(config, "", " ")
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(filepath.Join(config.ExportFailurePath, FailureReplayFile), bz, 0644)
+}
+
+// NewReplayConfig reads the config exported along with a failed simulation.
+// Since a simulation is deterministic given its seed and config, running it
+// again replays the simulation up to the failure height, after which it halts
+// with an error if the failure could not be reproduced.
+func NewReplayConfig(path string) (Config, error) {
+	bz, err := ioutil.ReadFile(path)
+	if err != nil {
+		return Config{}, err
+	}
+
+	var config Config
+	if err := json.Unmarshal(bz, &config); err != nil {
+		return Config{}, err
+	}
+
+	if config.ReplayHeight <= 0 {
+		return Config{}, fmt.Errorf("invalid replay height: %d", config.ReplayHeight)
+	}
+
+	return config, nil
+}
@@ -0,0 +1,72 @@
+package simulation
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportFailure(t *testing.T) {
+	dir, err := ioutil.TempDir("", "simulation-failure")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	config := Config{Seed: 99, NumBlocks: 100, BlockSize: 200, ExportFailurePath: dir}
+	appState := json.RawMessage(`{"bank":{}}`)
+	exportStateFn := func() (json.RawMessage, error) { return appState, nil }
+
+	logWriter := NewLogWriter(true)
+	logWriter.AddEntry(BeginBlockEntry(42))
+
+	require.NoError(t, exportFailure(ioutil.Discard, config, 42, exportStateFn, logWriter))
+
+	bz, err := ioutil.ReadFile(filepath.Join(dir, FailureAppStateFile))
+	require.NoError(t, err)
+	require.Equal(t, []byte(appState), bz)
+
+	bz, err = ioutil.ReadFile(filepath.Join(dir, FailureOperationsFile))
+	require.NoError(t, err)
+	require.Contains(t, string(bz), "begin_block")
+
+	replayConfig, err := NewReplayConfig(filepath.Join(dir, FailureReplayFile))
+	require.NoError(t, err)
+
+	config.ReplayHeight = 42
+	require.Equal(t, config, replayConfig)
+}
+
+func TestNewReplayConfigInvalidHeight(t *testing.T) {
+	dir, err := ioutil.TempDir("", "simulation-replay")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	bz, err := json.Marshal(Config{Seed: 99})
+	require.NoError(t, err)
+
+	path := filepath.Join(dir, FailureReplayFile)
+	require.NoError(t, ioutil.WriteFile(path, bz, 0644))
+
+	_, err = NewReplayConfig(path)
+	require.Error(t, err)
+}
+
+func TestExportFailurePanic(t *testing.T) {
+	dir, err := ioutil.TempDir("", "simulation-failure")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	config := Config{Seed: 99, ExportFailurePath: dir}
+	exportStateFn := func() (json.RawMessage, error) { panic("corrupted state") }
+
+	// a panic while exporting the app state is returned as an error
+	var exportErr error
+	require.NotPanics(t, func() {
+		exportErr = exportFailure(ioutil.Discard, config, 42, exportStateFn, NewLogWriter(true))
+	})
+	require.Error(t, exportErr)
+	require.Contains(t, exportErr.Error(), "corrupted state")
+}
@@ -11,6 +11,7 @@ import (
 type LogWriter interface {
 	AddEntry(OperationEntry)
 	PrintLogs()
+	ExportLogs(path string) error
 }
 
 // LogWriter - return a dummy or standard log writer given the testingmode
@@ -34,13 +35,30 @@ func (lw *StandardLogWriter) AddEntry(opEntry OperationEntry) {
 // PrintLogs - print the logs to a simulation file
 func (lw *StandardLogWriter) PrintLogs() {
 	f := createLogFile()
+	if err := lw.writeEntries(f); err != nil {
+		panic("Failed to write logs to file")
+	}
+}
+
+// ExportLogs - write the logs to the file at the given path
+func (lw *StandardLogWriter) ExportLogs(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return lw.writeEntries(f)
+}
+
+func (lw *StandardLogWriter) writeEntries(f *os.File) error {
 	for i := 0; i < len(lw.OpEntries); i++ {
 		writeEntry := fmt.Sprintf("%s\n", (lw.OpEntries[i]).MustMarshal())
-		_, err := f.WriteString(writeEntry)
-		if err != nil {
-			panic("Failed to write logs to file")
+		if _, err := f.WriteString(writeEntry); err != nil {
+			return err
 		}
 	}
+	return nil
 }
 
 func createLogFile() *os.File {
@@ -68,3 +86,6 @@ func (lw *DummyLogWriter) AddEntry(_ OperationEntry) {}
 
 // do nothing
 func (lw *DummyLogWriter) PrintLogs() {}
+
+// do nothing
+func (lw *DummyLogWriter) ExportLogs(_ string) error { return nil }
@@ -42,10 +42,14 @@ func initChain(
 
 // SimulateFromSeed tests an application by running the provided
 // operations, testing the provided invariants, but using the provided config.Seed.
+// If config.ExportFailurePath is set, the app state returned by exportStateFn,
+// the operation log and the config to replay the simulation are saved there
+// when the simulation fails. A replayed simulation, i.e. one with
+// config.ReplayHeight set, halts with an error after the replay height.
 // TODO: split this monster function up
 func SimulateFromSeed(
 	tb testing.TB, w io.Writer, app *baseapp.BaseApp,
-	appStateFn AppStateFn, ops WeightedOperations,
+	appStateFn AppStateFn, exportStateFn ExportStateFn, ops WeightedOperations,
 	blackListedAccs map[string]bool, config Config,
 ) (stopEarly bool, exportedParams Params, err error) {
 
@@ -121,14 +125,29 @@ func SimulateFromSeed(
 		testingMode, tb, t, w, params, eventStats.Tally,
 		ops, operationQueue, timeOperationQueue, logWriter, config)
 
+	if config.ReplayHeight != 0 {
+		fmt.Fprintf(w, "Replaying simulation with seed %d up to height %d\n", config.Seed, config.ReplayHeight)
+	}
+
 	if !testingMode {
 		b.ResetTimer()
 	} else {
-		// recover logs in case of panic
+		// recover logs in case of panic, and export the failure if the
+		// simulation panicked or failed the test
 		defer func() {
-			if r := recover(); r != nil {
-				_, _ = fmt.Fprintf(w, "simulation halted due to panic on block %d\n", header.Height)
+			r := recover()
+			if r != nil {
+				_, _ = fmt.Fprintf(w, "simulation halted due to panic on block %d: %v\n", header.Height, r)
 				logWriter.PrintLogs()
+			}
+
+			if (r != nil || tb.Failed()) && config.ExportFailurePath != "" {
+				if err := exportFailure(w, config, header.Height, exportStateFn, logWriter); err != nil {
+					_, _ = fmt.Fprintf(w, "failed to export simulation failure: %s\n", err)
+				}
+			}
+
+			if r != nil {
 				panic(r)
 			}
 		}()
@@ -202,6 +221,14 @@ func SimulateFromSeed(
 		if config.ExportParamsPath != "" && config.ExportParamsHeight == height {
 			exportedParams = params
 		}
+
+		// a replayed simulation that got past its replay height did not
+		// reproduce the failure
+		if config.ReplayHeight != 0 && height >= config.ReplayHeight {
+			fmt.Fprintf(w, "\nSimulation replayed up to height %d without failing\n", config.ReplayHeight)
+			err = fmt.Errorf("failure at height %d not reproduced; the simulation may be non-deterministic", config.ReplayHeight)
+			stopEarly = true
+		}
 	}
 
 	if stopEarly {
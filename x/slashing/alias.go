@@ -30,6 +30,9 @@ const (
 	AttributeValueDoubleSign       = types.AttributeValueDoubleSign
 	AttributeValueMissingSignature = types.AttributeValueMissingSignature
 	AttributeValueCategory         = types.AttributeValueCategory
+
+	InfractionDowntime   = types.InfractionDowntime
+	InfractionDoubleSign = types.InfractionDoubleSign
 )
 
 var (
@@ -44,6 +47,7 @@ var (
 	ErrMissingSelfDelegation                 = types.ErrMissingSelfDelegation
 	ErrSelfDelegationTooLowToUnjail          = types.ErrSelfDelegationTooLowToUnjail
 	ErrNoSigningInfoFound                    = types.ErrNoSigningInfoFound
+	ErrUnknownInfraction                     = types.ErrUnknownInfraction
 	NewGenesisState                          = types.NewGenesisState
 	NewMissedBlock                           = types.NewMissedBlock
 	DefaultGenesisState                      = types.DefaultGenesisState
@@ -61,6 +65,9 @@ var (
 	NewQuerySigningInfosParams               = types.NewQuerySigningInfosParams
 	NewValidatorSigningInfo                  = types.NewValidatorSigningInfo
 
+	NewInfractionParams = types.NewInfractionParams
+	DefaultInfractions  = types.DefaultInfractions
+
 	// variable aliases
 	ModuleCdc                       = types.ModuleCdc
 	ValidatorSigningInfoKey         = types.ValidatorSigningInfoKey
@@ -71,9 +78,9 @@ var (
 	DefaultSlashFractionDowntime    = types.DefaultSlashFractionDowntime
 	KeySignedBlocksWindow           = types.KeySignedBlocksWindow
 	KeyMinSignedPerWindow           = types.KeyMinSignedPerWindow
-	KeyDowntimeJailDuration         = types.KeyDowntimeJailDuration
-	KeySlashFractionDoubleSign      = types.KeySlashFractionDoubleSign
-	KeySlashFractionDowntime        = types.KeySlashFractionDowntime
+
+	KeyInfractions  = types.KeyInfractions
+	JailForeverTime = types.JailForeverTime
 )
 
 type (
@@ -86,4 +93,7 @@ type (
 	QuerySigningInfoParams  = types.QuerySigningInfoParams
 	QuerySigningInfosParams = types.QuerySigningInfosParams
 	ValidatorSigningInfo    = types.ValidatorSigningInfo
+
+	InfractionParams = types.InfractionParams
+	Infractions      = types.Infractions
 )
@@ -6,6 +6,7 @@ import (
 	"github.com/tendermint/tendermint/crypto"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/slashing/internal/types"
 )
 
@@ -91,10 +92,9 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr crypto.Address, p
 					sdk.NewAttribute(types.AttributeKeyJailed, consAddr.String()),
 				),
 			)
-			k.sk.Slash(ctx, consAddr, distributionHeight, power, k.SlashFractionDowntime(ctx))
-			k.sk.Jail(ctx, consAddr)
-
-			signInfo.JailedUntil = ctx.BlockHeader().Time.Add(k.DowntimeJailDuration(ctx))
+			if err := k.punish(ctx, consAddr, &signInfo, types.InfractionDowntime, power, distributionHeight); err != nil {
+				panic(err)
+			}
 
 			// We need to reset the counter & array so that the validator won't be immediately slashed for downtime upon rebonding.
 			signInfo.MissedBlocksCounter = 0
@@ -111,3 +111,68 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr crypto.Address, p
 	// Set the updated signing info
 	k.SetValidatorSigningInfo(ctx, consAddr, signInfo)
 }
+
+// HandleInfraction punishes a validator for the given infraction type according
+// to the Infractions parameter: the validator is slashed by the infraction's
+// slash fraction and jailed for its jail duration, or tombstoned. Modules
+// reporting misbehaviour, e.g. evidence handlers, route it through here. The
+// power and distribution height are those at the time of the infraction. An
+// error is returned if the infraction type is not registered or the validator
+// has no signing info.
+func (k Keeper) HandleInfraction(
+	ctx sdk.Context, consAddr sdk.ConsAddress, infraction string, power, distributionHeight int64,
+) error {
+
+	signInfo, found := k.GetValidatorSigningInfo(ctx, consAddr)
+	if !found {
+		return sdkerrors.Wrap(types.ErrNoSigningInfoFound, consAddr.String())
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSlash,
+			sdk.NewAttribute(types.AttributeKeyAddress, consAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyPower, fmt.Sprintf("%d", power)),
+			sdk.NewAttribute(types.AttributeKeyReason, infraction),
+			sdk.NewAttribute(types.AttributeKeyJailed, consAddr.String()),
+		),
+	)
+
+	if err := k.punish(ctx, consAddr, &signInfo, infraction, power, distributionHeight); err != nil {
+		return err
+	}
+
+	k.SetValidatorSigningInfo(ctx, consAddr, signInfo)
+	return nil
+}
+
+// punish slashes and jails the validator for the given infraction type and
+// updates its signing info accordingly. The signing info is not persisted.
+func (k Keeper) punish(
+	ctx sdk.Context, consAddr sdk.ConsAddress, signInfo *types.ValidatorSigningInfo,
+	infraction string, power, distributionHeight int64,
+) error {
+
+	params, ok := k.GetInfractionParams(ctx, infraction)
+	if !ok {
+		return sdkerrors.Wrap(types.ErrUnknownInfraction, infraction)
+	}
+
+	k.sk.Slash(ctx, consAddr, distributionHeight, power, params.SlashFraction)
+
+	// jail the validator if not already jailed, this will begin unbonding the
+	// validator if not already unbonding
+	validator := k.sk.ValidatorByConsAddr(ctx, consAddr)
+	if validator != nil && !validator.IsJailed() {
+		k.sk.Jail(ctx, consAddr)
+	}
+
+	if params.Tombstone {
+		signInfo.JailedUntil = types.JailForeverTime
+		signInfo.Tombstoned = true
+	} else {
+		signInfo.JailedUntil = ctx.BlockHeader().Time.Add(params.JailDuration)
+	}
+
+	return nil
+}
@@ -221,3 +221,51 @@ func TestValidatorDippingInAndOut(t *testing.T) {
 	require.Equal(t, sdk.Unbonding, validator.Status)
 
 }
+
+// Test punishing a validator for an infraction type registered through the
+// Infractions parameter
+func TestHandleInfraction(t *testing.T) {
+	ctx, _, sk, _, keeper := CreateTestInput(t, TestParams())
+	power := int64(100)
+	amt := sdk.TokensFromConsensusPower(power)
+	addr, val := Addrs[0], Pks[0]
+	consAddr := sdk.ConsAddress(val.Address())
+	sh := staking.NewHandler(sk)
+
+	res, err := sh(ctx, NewTestMsgCreateValidator(addr, val, amt))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	staking.EndBlocker(ctx, sk)
+
+	// unregistered infraction types cannot be handled
+	err = keeper.HandleInfraction(ctx, consAddr, "misbehaviour", power, 0)
+	require.True(t, types.ErrUnknownInfraction.Is(err))
+
+	params := keeper.GetParams(ctx)
+	params.Infractions = append(params.Infractions,
+		types.NewInfractionParams("misbehaviour", sdk.NewDecWithPrec(1, 1), time.Hour, false),
+	)
+	keeper.SetParams(ctx, params)
+
+	ctx = ctx.WithBlockTime(time.Unix(100, 0))
+	require.NoError(t, keeper.HandleInfraction(ctx, consAddr, "misbehaviour", power, 0))
+
+	// validator should have been slashed and jailed, but not tombstoned
+	validator, _ := sk.GetValidatorByConsAddr(ctx, consAddr)
+	require.True(t, validator.IsJailed())
+	require.Equal(t, amt.Sub(amt.QuoRaw(10)), validator.GetTokens())
+
+	info, found := keeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.True(t, found)
+	require.Equal(t, time.Unix(100, 0).Add(time.Hour).UTC(), info.JailedUntil)
+	require.False(t, info.Tombstoned)
+
+	// the double sign infraction tombstones the validator by default
+	require.NoError(t, keeper.HandleInfraction(ctx, consAddr, types.InfractionDoubleSign, power, 0))
+
+	info, found = keeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.True(t, found)
+	require.Equal(t, types.JailForeverTime.UTC(), info.JailedUntil)
+	require.True(t, info.Tombstoned)
+}
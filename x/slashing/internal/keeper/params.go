@@ -24,28 +24,56 @@ func (k Keeper) MinSignedPerWindow(ctx sdk.Context) int64 {
 	return minSignedPerWindow.MulInt64(signedBlocksWindow).RoundInt64()
 }
 
-// DowntimeJailDuration - Downtime unbond duration
-func (k Keeper) DowntimeJailDuration(ctx sdk.Context) (res time.Duration) {
-	k.paramspace.Get(ctx, types.KeyDowntimeJailDuration, &res)
+// Parameter store keys of the punishments replaced by the Infractions
+// parameter. They are only read on chains upgraded in place that have not set
+// the Infractions parameter yet.
+var (
+	legacyKeyDowntimeJailDuration    = []byte("DowntimeJailDuration")
+	legacyKeySlashFractionDoubleSign = []byte("SlashFractionDoubleSign")
+	legacyKeySlashFractionDowntime   = []byte("SlashFractionDowntime")
+)
+
+// Infractions - punishments of the registered infraction types
+func (k Keeper) Infractions(ctx sdk.Context) (res types.Infractions) {
+	k.paramspace.GetIfExists(ctx, types.KeyInfractions, &res)
+	if res == nil {
+		res = k.legacyInfractions(ctx)
+	}
 	return
 }
 
-// SlashFractionDoubleSign - fraction of power slashed in case of double sign
-func (k Keeper) SlashFractionDoubleSign(ctx sdk.Context) (res sdk.Dec) {
-	k.paramspace.Get(ctx, types.KeySlashFractionDoubleSign, &res)
-	return
+// legacyInfractions returns the downtime and double sign punishments defined
+// by the parameters replaced by Infractions, using the defaults for any of them
+// that is not set.
+func (k Keeper) legacyInfractions(ctx sdk.Context) types.Infractions {
+	var (
+		downtimeJailDuration    = types.DefaultDowntimeJailDuration
+		slashFractionDoubleSign = types.DefaultSlashFractionDoubleSign
+		slashFractionDowntime   = types.DefaultSlashFractionDowntime
+	)
+
+	k.paramspace.GetIfExists(ctx, legacyKeyDowntimeJailDuration, &downtimeJailDuration)
+	k.paramspace.GetIfExists(ctx, legacyKeySlashFractionDoubleSign, &slashFractionDoubleSign)
+	k.paramspace.GetIfExists(ctx, legacyKeySlashFractionDowntime, &slashFractionDowntime)
+
+	return types.Infractions{
+		types.NewInfractionParams(types.InfractionDowntime, slashFractionDowntime, downtimeJailDuration, false),
+		types.NewInfractionParams(types.InfractionDoubleSign, slashFractionDoubleSign, time.Duration(0), true),
+	}
 }
 
-// SlashFractionDowntime - fraction of power slashed for downtime
-func (k Keeper) SlashFractionDowntime(ctx sdk.Context) (res sdk.Dec) {
-	k.paramspace.Get(ctx, types.KeySlashFractionDowntime, &res)
-	return
+// GetInfractionParams returns the punishment of the given infraction type, if
+// it is registered.
+func (k Keeper) GetInfractionParams(ctx sdk.Context, infraction string) (types.InfractionParams, bool) {
+	return k.Infractions(ctx).Get(infraction)
 }
 
 // GetParams returns the total set of slashing parameters.
-func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-	k.paramspace.GetParamSet(ctx, &params)
-	return params
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	var minSignedPerWindow sdk.Dec
+	k.paramspace.Get(ctx, types.KeyMinSignedPerWindow, &minSignedPerWindow)
+
+	return types.NewParams(k.SignedBlocksWindow(ctx), minSignedPerWindow, k.Infractions(ctx))
 }
 
 // SetParams sets the slashing parameters to the param space.
@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/slashing/internal/types"
+)
+
+// Test that a chain upgraded in place, which stores the punishments under the
+// parameters replaced by Infractions, keeps its punishments.
+func TestLegacyInfractions(t *testing.T) {
+	keyParams := sdk.NewKVStoreKey(params.StoreKey)
+	tkeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
+	cdc := createTestCodec()
+
+	noop := func(interface{}) error { return nil }
+	legacySpace := params.NewKeeper(cdc, keyParams, tkeyParams).Subspace(types.DefaultParamspace).WithKeyTable(
+		params.NewKeyTable(
+			params.NewParamSetPair(legacyKeyDowntimeJailDuration, time.Duration(0), noop),
+			params.NewParamSetPair(legacyKeySlashFractionDoubleSign, sdk.Dec{}, noop),
+		),
+	)
+
+	paramspace := params.NewKeeper(cdc, keyParams, tkeyParams).Subspace(types.DefaultParamspace).WithKeyTable(types.ParamKeyTable())
+	keeper := Keeper{paramspace: paramspace}
+
+	// nothing is stored, so the default punishments are used
+	require.Equal(t, types.DefaultInfractions(), keeper.Infractions(ctx))
+
+	legacySpace.Set(ctx, legacyKeyDowntimeJailDuration, time.Hour)
+	legacySpace.Set(ctx, legacyKeySlashFractionDoubleSign, sdk.NewDecWithPrec(1, 1))
+
+	// the stored punishments are used, with the default for the unset one
+	expected := types.Infractions{
+		types.NewInfractionParams(types.InfractionDowntime, types.DefaultSlashFractionDowntime, time.Hour, false),
+		types.NewInfractionParams(types.InfractionDoubleSign, sdk.NewDecWithPrec(1, 1), 0, true),
+	}
+	require.Equal(t, expected, keeper.Infractions(ctx))
+
+	downtime, found := keeper.GetInfractionParams(ctx, types.InfractionDowntime)
+	require.True(t, found)
+	require.Equal(t, time.Hour, downtime.JailDuration)
+
+	// once set, the Infractions parameter takes precedence
+	paramspace.Set(ctx, types.KeyInfractions, types.DefaultInfractions())
+	require.Equal(t, types.DefaultInfractions(), keeper.Infractions(ctx))
+}
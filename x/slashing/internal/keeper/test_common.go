@@ -144,7 +144,11 @@ func newPubKey(pk string) (res crypto.PubKey) {
 func TestParams() types.Params {
 	params := types.DefaultParams()
 	params.SignedBlocksWindow = 1000
-	params.DowntimeJailDuration = 60 * 60
+	for i, ip := range params.Infractions {
+		if ip.Infraction == types.InfractionDowntime {
+			params.Infractions[i].JailDuration = 60 * 60
+		}
+	}
 	return params
 }
 
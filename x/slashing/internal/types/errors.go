@@ -13,4 +13,5 @@ var (
 	ErrMissingSelfDelegation        = sdkerrors.Register(ModuleName, 5, "validator has no self-delegation; cannot be unjailed")
 	ErrSelfDelegationTooLowToUnjail = sdkerrors.Register(ModuleName, 6, "validator's self delegation less than minimum; cannot be unjailed")
 	ErrNoSigningInfoFound           = sdkerrors.Register(ModuleName, 7, "no validator signing info found")
+	ErrUnknownInfraction            = sdkerrors.Register(ModuleName, 8, "unknown infraction type")
 )
@@ -51,24 +51,18 @@ func DefaultGenesisState() GenesisState {
 
 // ValidateGenesis validates the slashing genesis parameters
 func ValidateGenesis(data GenesisState) error {
-	downtime := data.Params.SlashFractionDowntime
-	if downtime.IsNegative() || downtime.GT(sdk.OneDec()) {
-		return fmt.Errorf("slashing fraction downtime should be less than or equal to one and greater than zero, is %s", downtime.String())
-	}
-
-	dblSign := data.Params.SlashFractionDoubleSign
-	if dblSign.IsNegative() || dblSign.GT(sdk.OneDec()) {
-		return fmt.Errorf("slashing fraction double sign should be less than or equal to one and greater than zero, is %s", dblSign.String())
-	}
-
 	minSign := data.Params.MinSignedPerWindow
 	if minSign.IsNegative() || minSign.GT(sdk.OneDec()) {
 		return fmt.Errorf("min signed per window should be less than or equal to one and greater than zero, is %s", minSign.String())
 	}
 
-	downtimeJail := data.Params.DowntimeJailDuration
-	if downtimeJail < 1*time.Minute {
-		return fmt.Errorf("downtime unblond duration must be at least 1 minute, is %s", downtimeJail.String())
+	if err := validateInfractions(data.Params.Infractions); err != nil {
+		return err
+	}
+
+	downtime, _ := data.Params.Infractions.Get(InfractionDowntime)
+	if !downtime.Tombstone && downtime.JailDuration < 1*time.Minute {
+		return fmt.Errorf("downtime unblond duration must be at least 1 minute, is %s", downtime.JailDuration.String())
 	}
 
 	signedWindow := data.Params.SignedBlocksWindow
@@ -0,0 +1,95 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Infraction types punished by the slashing module. Additional infraction
+// types, e.g. misbehaviour reported by other modules through
+// Keeper.HandleInfraction, are added by registering them in the Infractions
+// parameter.
+const (
+	InfractionDowntime   = "downtime"
+	InfractionDoubleSign = "double_sign"
+)
+
+// JailForeverTime is the jail end time of tombstoned validators.
+var JailForeverTime = time.Unix(253402300799, 0)
+
+// InfractionParams defines the punishment of a single infraction type. A
+// tombstoned validator is jailed forever, so JailDuration is ignored when
+// Tombstone is set.
+type InfractionParams struct {
+	Infraction    string        `json:"infraction" yaml:"infraction"`
+	SlashFraction sdk.Dec       `json:"slash_fraction" yaml:"slash_fraction"`
+	JailDuration  time.Duration `json:"jail_duration" yaml:"jail_duration"`
+	Tombstone     bool          `json:"tombstone" yaml:"tombstone"`
+}
+
+// NewInfractionParams creates a new InfractionParams object
+func NewInfractionParams(
+	infraction string, slashFraction sdk.Dec, jailDuration time.Duration, tombstone bool,
+) InfractionParams {
+
+	return InfractionParams{
+		Infraction:    infraction,
+		SlashFraction: slashFraction,
+		JailDuration:  jailDuration,
+		Tombstone:     tombstone,
+	}
+}
+
+// String implements the stringer interface for InfractionParams
+func (ip InfractionParams) String() string {
+	return fmt.Sprintf(`%s:
+    SlashFraction: %s
+    JailDuration:  %s
+    Tombstone:     %t`,
+		ip.Infraction, ip.SlashFraction, ip.JailDuration, ip.Tombstone)
+}
+
+// Validate performs a stateless validation of the infraction parameters.
+func (ip InfractionParams) Validate() error {
+	if strings.TrimSpace(ip.Infraction) == "" {
+		return fmt.Errorf("infraction type cannot be blank")
+	}
+	if ip.SlashFraction.IsNil() || ip.SlashFraction.IsNegative() {
+		return fmt.Errorf("%s slash fraction cannot be negative: %s", ip.Infraction, ip.SlashFraction)
+	}
+	if ip.SlashFraction.GT(sdk.OneDec()) {
+		return fmt.Errorf("%s slash fraction too large: %s", ip.Infraction, ip.SlashFraction)
+	}
+	if !ip.Tombstone && ip.JailDuration <= 0 {
+		return fmt.Errorf("%s jail duration must be positive: %s", ip.Infraction, ip.JailDuration)
+	}
+
+	return nil
+}
+
+// Infractions defines the registry of punishable infraction types.
+type Infractions []InfractionParams
+
+// Get returns the parameters of the given infraction type, if it is
+// registered.
+func (i Infractions) Get(infraction string) (InfractionParams, bool) {
+	for _, ip := range i {
+		if ip.Infraction == infraction {
+			return ip, true
+		}
+	}
+
+	return InfractionParams{}, false
+}
+
+// DefaultInfractions returns the default punishments of the downtime and
+// double sign infractions.
+func DefaultInfractions() Infractions {
+	return Infractions{
+		NewInfractionParams(InfractionDowntime, DefaultSlashFractionDowntime, DefaultDowntimeJailDuration, false),
+		NewInfractionParams(InfractionDoubleSign, DefaultSlashFractionDoubleSign, 0, true),
+	}
+}
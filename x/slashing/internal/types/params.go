@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -23,11 +24,9 @@ var (
 
 // Parameter store keys
 var (
-	KeySignedBlocksWindow      = []byte("SignedBlocksWindow")
-	KeyMinSignedPerWindow      = []byte("MinSignedPerWindow")
-	KeyDowntimeJailDuration    = []byte("DowntimeJailDuration")
-	KeySlashFractionDoubleSign = []byte("SlashFractionDoubleSign")
-	KeySlashFractionDowntime   = []byte("SlashFractionDowntime")
+	KeySignedBlocksWindow = []byte("SignedBlocksWindow")
+	KeyMinSignedPerWindow = []byte("MinSignedPerWindow")
+	KeyInfractions        = []byte("Infractions")
 )
 
 // ParamKeyTable for slashing module
@@ -37,39 +36,37 @@ func ParamKeyTable() params.KeyTable {
 
 // Params - used for initializing default parameter for slashing at genesis
 type Params struct {
-	SignedBlocksWindow      int64         `json:"signed_blocks_window" yaml:"signed_blocks_window"`
-	MinSignedPerWindow      sdk.Dec       `json:"min_signed_per_window" yaml:"min_signed_per_window"`
-	DowntimeJailDuration    time.Duration `json:"downtime_jail_duration" yaml:"downtime_jail_duration"`
-	SlashFractionDoubleSign sdk.Dec       `json:"slash_fraction_double_sign" yaml:"slash_fraction_double_sign"`
-	SlashFractionDowntime   sdk.Dec       `json:"slash_fraction_downtime" yaml:"slash_fraction_downtime"`
+	SignedBlocksWindow int64       `json:"signed_blocks_window" yaml:"signed_blocks_window"`
+	MinSignedPerWindow sdk.Dec     `json:"min_signed_per_window" yaml:"min_signed_per_window"`
+	Infractions        Infractions `json:"infractions" yaml:"infractions"`
 }
 
 // NewParams creates a new Params object
 func NewParams(
-	signedBlocksWindow int64, minSignedPerWindow sdk.Dec, downtimeJailDuration time.Duration,
-	slashFractionDoubleSign, slashFractionDowntime sdk.Dec,
+	signedBlocksWindow int64, minSignedPerWindow sdk.Dec, infractions Infractions,
 ) Params {
 
 	return Params{
-		SignedBlocksWindow:      signedBlocksWindow,
-		MinSignedPerWindow:      minSignedPerWindow,
-		DowntimeJailDuration:    downtimeJailDuration,
-		SlashFractionDoubleSign: slashFractionDoubleSign,
-		SlashFractionDowntime:   slashFractionDowntime,
+		SignedBlocksWindow: signedBlocksWindow,
+		MinSignedPerWindow: minSignedPerWindow,
+		Infractions:        infractions,
 	}
 }
 
 // String implements the stringer interface for Params
 func (p Params) String() string {
+	infractions := make([]string, len(p.Infractions))
+	for i, ip := range p.Infractions {
+		infractions[i] = "  " + ip.String()
+	}
+
 	return fmt.Sprintf(`Slashing Params:
-  SignedBlocksWindow:      %d
-  MinSignedPerWindow:      %s
-  DowntimeJailDuration:    %s
-  SlashFractionDoubleSign: %s
-  SlashFractionDowntime:   %s`,
+  SignedBlocksWindow: %d
+  MinSignedPerWindow: %s
+Infractions:
+%s`,
 		p.SignedBlocksWindow, p.MinSignedPerWindow,
-		p.DowntimeJailDuration, p.SlashFractionDoubleSign,
-		p.SlashFractionDowntime)
+		strings.Join(infractions, "\n"))
 }
 
 // ParamSetPairs - Implements params.ParamSet
@@ -77,17 +74,14 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 	return params.ParamSetPairs{
 		params.NewParamSetPair(KeySignedBlocksWindow, &p.SignedBlocksWindow, validateSignedBlocksWindow),
 		params.NewParamSetPair(KeyMinSignedPerWindow, &p.MinSignedPerWindow, validateMinSignedPerWindow),
-		params.NewParamSetPair(KeyDowntimeJailDuration, &p.DowntimeJailDuration, validateDowntimeJailDuration),
-		params.NewParamSetPair(KeySlashFractionDoubleSign, &p.SlashFractionDoubleSign, validateSlashFractionDoubleSign),
-		params.NewParamSetPair(KeySlashFractionDowntime, &p.SlashFractionDowntime, validateSlashFractionDowntime),
+		params.NewParamSetPair(KeyInfractions, &p.Infractions, validateInfractions),
 	}
 }
 
 // DefaultParams defines the parameters for this module
 func DefaultParams() Params {
 	return NewParams(
-		DefaultSignedBlocksWindow, DefaultMinSignedPerWindow, DefaultDowntimeJailDuration,
-		DefaultSlashFractionDoubleSign, DefaultSlashFractionDowntime,
+		DefaultSignedBlocksWindow, DefaultMinSignedPerWindow, DefaultInfractions(),
 	)
 }
 
@@ -120,46 +114,30 @@ func validateMinSignedPerWindow(i interface{}) error {
 	return nil
 }
 
-func validateDowntimeJailDuration(i interface{}) error {
-	v, ok := i.(time.Duration)
-	if !ok {
-		return fmt.Errorf("invalid parameter type: %T", i)
-	}
-
-	if v <= 0 {
-		return fmt.Errorf("downtime jail duration must be positive: %s", v)
-	}
-
-	return nil
-}
-
-func validateSlashFractionDoubleSign(i interface{}) error {
-	v, ok := i.(sdk.Dec)
+func validateInfractions(i interface{}) error {
+	v, ok := i.(Infractions)
 	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
 
-	if v.IsNegative() {
-		return fmt.Errorf("double sign slash fraction cannot be negative: %s", v)
-	}
-	if v.GT(sdk.OneDec()) {
-		return fmt.Errorf("double sign slash fraction too large: %s", v)
-	}
-
-	return nil
-}
+	seen := make(map[string]bool, len(v))
+	for _, ip := range v {
+		if err := ip.Validate(); err != nil {
+			return err
+		}
+		if seen[ip.Infraction] {
+			return fmt.Errorf("duplicate infraction type: %s", ip.Infraction)
+		}
 
-func validateSlashFractionDowntime(i interface{}) error {
-	v, ok := i.(sdk.Dec)
-	if !ok {
-		return fmt.Errorf("invalid parameter type: %T", i)
+		seen[ip.Infraction] = true
 	}
 
-	if v.IsNegative() {
-		return fmt.Errorf("downtime slash fraction cannot be negative: %s", v)
-	}
-	if v.GT(sdk.OneDec()) {
-		return fmt.Errorf("downtime slash fraction too large: %s", v)
+	// the infractions punished by the slashing and evidence modules themselves
+	// must always be registered
+	for _, infraction := range []string{InfractionDowntime, InfractionDoubleSign} {
+		if !seen[infraction] {
+			return fmt.Errorf("missing parameters for infraction type: %s", infraction)
+		}
 	}
 
 	return nil
@@ -0,0 +1,30 @@
+package v039
+
+import (
+	v038slashing "github.com/cosmos/cosmos-sdk/x/slashing/legacy/v0_38"
+)
+
+// Migrate accepts the exported x/slashing params from v0.38 and migrates them
+// to v0.39 params, where the downtime and double sign punishments are
+// registered in the Infractions parameter. Double signing tombstones the
+// validator, as it did before. The remaining x/slashing genesis state is
+// unchanged.
+func Migrate(oldParams v038slashing.Params) Params {
+	return Params{
+		SignedBlocksWindow: oldParams.SignedBlocksWindow,
+		MinSignedPerWindow: oldParams.MinSignedPerWindow,
+		Infractions: Infractions{
+			{
+				Infraction:    InfractionDowntime,
+				SlashFraction: oldParams.SlashFractionDowntime,
+				JailDuration:  oldParams.DowntimeJailDuration,
+				Tombstone:     false,
+			},
+			{
+				Infraction:    InfractionDoubleSign,
+				SlashFraction: oldParams.SlashFractionDoubleSign,
+				Tombstone:     true,
+			},
+		},
+	}
+}
@@ -0,0 +1,47 @@
+package v039_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	v038slashing "github.com/cosmos/cosmos-sdk/x/slashing/legacy/v0_38"
+	v039slashing "github.com/cosmos/cosmos-sdk/x/slashing/legacy/v0_39"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrate(t *testing.T) {
+	v039Codec := codec.New()
+
+	var oldParams v038slashing.Params
+	v039Codec.MustUnmarshalJSON([]byte(`{
+  "signed_blocks_window": "100",
+  "min_signed_per_window": "0.500000000000000000",
+  "downtime_jail_duration": "600000000000",
+  "slash_fraction_double_sign": "0.050000000000000000",
+  "slash_fraction_downtime": "0.010000000000000000"
+}`), &oldParams)
+
+	expected := `{
+  "signed_blocks_window": "100",
+  "min_signed_per_window": "0.500000000000000000",
+  "infractions": [
+    {
+      "infraction": "downtime",
+      "slash_fraction": "0.010000000000000000",
+      "jail_duration": "600000000000",
+      "tombstone": false
+    },
+    {
+      "infraction": "double_sign",
+      "slash_fraction": "0.050000000000000000",
+      "jail_duration": "0",
+      "tombstone": true
+    }
+  ]
+}`
+
+	bz, err := v039Codec.MarshalJSONIndent(v039slashing.Migrate(oldParams), "", "  ")
+	require.NoError(t, err)
+	require.Equal(t, expected, string(bz))
+}
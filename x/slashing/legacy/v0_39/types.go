@@ -0,0 +1,34 @@
+package v039
+
+// DONTCOVER
+// nolint
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	ModuleName = "slashing"
+
+	InfractionDowntime   = "downtime"
+	InfractionDoubleSign = "double_sign"
+)
+
+type (
+	InfractionParams struct {
+		Infraction    string        `json:"infraction" yaml:"infraction"`
+		SlashFraction sdk.Dec       `json:"slash_fraction" yaml:"slash_fraction"`
+		JailDuration  time.Duration `json:"jail_duration" yaml:"jail_duration"`
+		Tombstone     bool          `json:"tombstone" yaml:"tombstone"`
+	}
+
+	Infractions []InfractionParams
+
+	Params struct {
+		SignedBlocksWindow int64       `json:"signed_blocks_window" yaml:"signed_blocks_window"`
+		MinSignedPerWindow sdk.Dec     `json:"min_signed_per_window" yaml:"min_signed_per_window"`
+		Infractions        Infractions `json:"infractions" yaml:"infractions"`
+	}
+)
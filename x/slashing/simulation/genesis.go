@@ -81,10 +81,12 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { slashFractionDowntime = GenSlashFractionDowntime(r) },
 	)
 
-	params := types.NewParams(
-		signedBlocksWindow, minSignedPerWindow, downtimeJailDuration,
-		slashFractionDoubleSign, slashFractionDowntime,
-	)
+	infractions := types.Infractions{
+		types.NewInfractionParams(types.InfractionDowntime, slashFractionDowntime, downtimeJailDuration, false),
+		types.NewInfractionParams(types.InfractionDoubleSign, slashFractionDoubleSign, 0, true),
+	}
+
+	params := types.NewParams(signedBlocksWindow, minSignedPerWindow, infractions)
 
 	slashingGenesis := types.NewGenesisState(params, nil, nil)
 
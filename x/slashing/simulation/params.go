@@ -11,9 +11,9 @@ import (
 )
 
 const (
-	keySignedBlocksWindow    = "SignedBlocksWindow"
-	keyMinSignedPerWindow    = "MinSignedPerWindow"
-	keySlashFractionDowntime = "SlashFractionDowntime"
+	keySignedBlocksWindow = "SignedBlocksWindow"
+	keyMinSignedPerWindow = "MinSignedPerWindow"
+	keyInfractions        = "Infractions"
 )
 
 // ParamChanges defines the parameters that can be modified by param change proposals
@@ -30,9 +30,14 @@ func ParamChanges(r *rand.Rand) []simulation.ParamChange {
 				return fmt.Sprintf("\"%s\"", GenMinSignedPerWindow(r))
 			},
 		),
-		simulation.NewSimParamChange(types.ModuleName, keySlashFractionDowntime,
+		simulation.NewSimParamChange(types.ModuleName, keyInfractions,
 			func(r *rand.Rand) string {
-				return fmt.Sprintf("\"%s\"", GenSlashFractionDowntime(r))
+				return fmt.Sprintf(
+					`[{"infraction":"%s","slash_fraction":"%s","jail_duration":"%d","tombstone":false},`+
+						`{"infraction":"%s","slash_fraction":"%s","jail_duration":"0","tombstone":true}]`,
+					types.InfractionDowntime, GenSlashFractionDowntime(r), GenDowntimeJailDuration(r),
+					types.InfractionDoubleSign, GenSlashFractionDoubleSign(r),
+				)
 			},
 		),
 	}
@@ -13,7 +13,8 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 	k.TrackHistoricalInfo(ctx)
 }
 
-// Called every block, update validator set
+// Called every block, enforce the minimum commission rate and update validator set
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) []abci.ValidatorUpdate {
+	k.ApplyMinCommissionRate(ctx)
 	return k.BlockValidatorUpdates(ctx)
 }
@@ -124,6 +124,7 @@ var (
 	ErrInvalidHistoricalInfo           = types.ErrInvalidHistoricalInfo
 	ErrNoHistoricalInfo                = types.ErrNoHistoricalInfo
 	ErrEmptyValidatorPubKey            = types.ErrEmptyValidatorPubKey
+	ErrCommissionLTMinRate             = types.ErrCommissionLTMinRate
 	NewGenesisState                    = types.NewGenesisState
 	DefaultGenesisState                = types.DefaultGenesisState
 	NewMultiStakingHooks               = types.NewMultiStakingHooks
@@ -175,6 +176,11 @@ var (
 	UnmarshalValidator                 = types.UnmarshalValidator
 	NewDescription                     = types.NewDescription
 
+	NewQueryDelegatorPaginatedParams     = types.NewQueryDelegatorPaginatedParams
+	NewQueryValidatorPaginatedParams     = types.NewQueryValidatorPaginatedParams
+	NewQueryDelegationsResponse          = types.NewQueryDelegationsResponse
+	NewQueryUnbondingDelegationsResponse = types.NewQueryUnbondingDelegationsResponse
+
 	// variable aliases
 	NewCodec                         = types.NewCodec
 	ModuleCdc                        = types.ModuleCdc
@@ -197,6 +203,8 @@ var (
 	KeyMaxValidators                 = types.KeyMaxValidators
 	KeyMaxEntries                    = types.KeyMaxEntries
 	KeyBondDenom                     = types.KeyBondDenom
+	KeyMinCommissionRate             = types.KeyMinCommissionRate
+	DefaultMinCommissionRate         = types.DefaultMinCommissionRate
 )
 
 type (
@@ -241,4 +249,7 @@ type (
 	Description               = types.Description
 	DelegationI               = exported.DelegationI
 	ValidatorI                = exported.ValidatorI
+
+	QueryDelegationsResponse          = types.QueryDelegationsResponse
+	QueryUnbondingDelegationsResponse = types.QueryUnbondingDelegationsResponse
 )
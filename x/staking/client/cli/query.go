@@ -127,7 +127,7 @@ $ %s query staking validators
 
 // GetCmdQueryValidatorUnbondingDelegations implements the query all unbonding delegatations from a validator command.
 func GetCmdQueryValidatorUnbondingDelegations(queryRoute string, cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "unbonding-delegations-from [validator-addr]",
 		Short: "Query all unbonding delegatations from a validator",
 		Long: strings.TrimSpace(
@@ -148,7 +148,12 @@ $ %s query staking unbonding-delegations-from cosmosvaloper1gghjut3ccd8ay0zduzj6
 				return err
 			}
 
-			bz, err := cdc.MarshalJSON(types.NewQueryValidatorParams(valAddr))
+			pageReq, err := client.ReadPageRequest()
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryValidatorPaginatedParams(valAddr, pageReq))
 			if err != nil {
 				return err
 			}
@@ -159,11 +164,17 @@ $ %s query staking unbonding-delegations-from cosmosvaloper1gghjut3ccd8ay0zduzj6
 				return err
 			}
 
-			var ubds types.UnbondingDelegations
-			cdc.MustUnmarshalJSON(res, &ubds)
-			return cliCtx.PrintOutput(ubds)
+			var resp types.QueryUnbondingDelegationsResponse
+			if err := cdc.UnmarshalJSON(res, &resp); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(resp)
 		},
 	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "unbonding delegations")
+	return cmd
 }
 
 // GetCmdQueryValidatorRedelegations implements the query all redelegatations
@@ -263,7 +274,7 @@ $ %s query staking delegation cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p cosm
 // GetCmdQueryDelegations implements the command to query all the delegations
 // made from one delegator.
 func GetCmdQueryDelegations(queryRoute string, cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "delegations [delegator-addr]",
 		Short: "Query all delegations made by one delegator",
 		Long: strings.TrimSpace(
@@ -284,7 +295,12 @@ $ %s query staking delegations cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
 				return err
 			}
 
-			bz, err := cdc.MarshalJSON(types.NewQueryDelegatorParams(delAddr))
+			pageReq, err := client.ReadPageRequest()
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryDelegatorPaginatedParams(delAddr, pageReq))
 			if err != nil {
 				return err
 			}
@@ -295,7 +311,7 @@ $ %s query staking delegations cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
 				return err
 			}
 
-			var resp types.DelegationResponses
+			var resp types.QueryDelegationsResponse
 			if err := cdc.UnmarshalJSON(res, &resp); err != nil {
 				return err
 			}
@@ -303,12 +319,15 @@ $ %s query staking delegations cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
 			return cliCtx.PrintOutput(resp)
 		},
 	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "delegations")
+	return cmd
 }
 
 // GetCmdQueryValidatorDelegations implements the command to query all the
 // delegations to a specific validator.
 func GetCmdQueryValidatorDelegations(queryRoute string, cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "delegations-to [validator-addr]",
 		Short: "Query all delegations made to one validator",
 		Long: strings.TrimSpace(
@@ -329,7 +348,12 @@ $ %s query staking delegations-to cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ld
 				return err
 			}
 
-			bz, err := cdc.MarshalJSON(types.NewQueryValidatorParams(valAddr))
+			pageReq, err := client.ReadPageRequest()
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryValidatorPaginatedParams(valAddr, pageReq))
 			if err != nil {
 				return err
 			}
@@ -340,7 +364,7 @@ $ %s query staking delegations-to cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ld
 				return err
 			}
 
-			var resp types.DelegationResponses
+			var resp types.QueryDelegationsResponse
 			if err := cdc.UnmarshalJSON(res, &resp); err != nil {
 				return err
 			}
@@ -348,6 +372,9 @@ $ %s query staking delegations-to cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ld
 			return cliCtx.PrintOutput(resp)
 		},
 	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "delegations")
+	return cmd
 }
 
 // GetCmdQueryUnbondingDelegation implements the command to query a single
@@ -403,7 +430,7 @@ $ %s query staking unbonding-delegation cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld7
 // GetCmdQueryUnbondingDelegations implements the command to query all the
 // unbonding-delegation records for a delegator.
 func GetCmdQueryUnbondingDelegations(queryRoute string, cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "unbonding-delegations [delegator-addr]",
 		Short: "Query all unbonding-delegations records for one delegator",
 		Long: strings.TrimSpace(
@@ -424,7 +451,12 @@ $ %s query staking unbonding-delegation cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld7
 				return err
 			}
 
-			bz, err := cdc.MarshalJSON(types.NewQueryDelegatorParams(delegatorAddr))
+			pageReq, err := client.ReadPageRequest()
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryDelegatorPaginatedParams(delegatorAddr, pageReq))
 			if err != nil {
 				return err
 			}
@@ -435,14 +467,17 @@ $ %s query staking unbonding-delegation cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld7
 				return err
 			}
 
-			var ubds types.UnbondingDelegations
-			if err = cdc.UnmarshalJSON(res, &ubds); err != nil {
+			var resp types.QueryUnbondingDelegationsResponse
+			if err = cdc.UnmarshalJSON(res, &resp); err != nil {
 				return err
 			}
 
-			return cliCtx.PrintOutput(ubds)
+			return cliCtx.PrintOutput(resp)
 		},
 	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "unbonding delegations")
+	return cmd
 }
 
 // GetCmdQueryRedelegation implements the command to query a single
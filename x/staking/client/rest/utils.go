@@ -93,7 +93,14 @@ func queryDelegator(cliCtx context.CLIContext, endpoint string) http.HandlerFunc
 			return
 		}
 
-		params := types.NewQueryDelegatorParams(delegatorAddr)
+		// pagination is only used by the endpoints returning paginated results
+		pageReq, err := rest.ParsePageRequest(r)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		params := types.NewQueryDelegatorPaginatedParams(delegatorAddr, pageReq)
 
 		bz, err := cliCtx.Codec.MarshalJSON(params)
 		if err != nil {
@@ -128,7 +135,14 @@ func queryValidator(cliCtx context.CLIContext, endpoint string) http.HandlerFunc
 			return
 		}
 
-		params := types.NewQueryValidatorParams(validatorAddr)
+		// pagination is only used by the endpoints returning paginated results
+		pageReq, err := rest.ParsePageRequest(r)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		params := types.NewQueryValidatorPaginatedParams(validatorAddr, pageReq)
 
 		bz, err := cliCtx.Codec.MarshalJSON(params)
 		if err != nil {
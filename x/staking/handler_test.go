@@ -386,6 +386,128 @@ func TestEditValidatorDecreaseMinSelfDelegation(t *testing.T) {
 	require.Nil(t, res)
 }
 
+func TestMinCommissionRate(t *testing.T) {
+	initPower := int64(100)
+	initBond := sdk.TokensFromConsensusPower(10)
+	ctx, _, _, keeper, _ := keep.CreateTestInput(t, false, initPower)
+
+	params := keeper.GetParams(ctx)
+	params.MinCommissionRate = sdk.NewDecWithPrec(5, 2)
+	keeper.SetParams(ctx, params)
+
+	// creating a validator below the minimum rate fails
+	msgCreateValidator := NewTestMsgCreateValidatorWithCommission(
+		sdk.ValAddress(keep.Addrs[0]), keep.PKs[0], initBond, sdk.NewDecWithPrec(1, 2),
+	)
+	res, err := handleMsgCreateValidator(ctx, msgCreateValidator, keeper)
+	require.True(t, types.ErrCommissionLTMinRate.Is(err))
+	require.Nil(t, res)
+
+	// creating a validator at the minimum rate succeeds
+	validatorAddr := sdk.ValAddress(keep.Addrs[1])
+	msgCreateValidator = types.NewMsgCreateValidator(
+		validatorAddr, keep.PKs[1], sdk.NewCoin(sdk.DefaultBondDenom, initBond), Description{},
+		NewCommissionRates(sdk.NewDecWithPrec(5, 2), sdk.OneDec(), sdk.OneDec()), sdk.OneInt(),
+	)
+	res, err = handleMsgCreateValidator(ctx, msgCreateValidator, keeper)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	// editing the commission below the minimum rate fails
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(48 * time.Hour))
+	newRate := sdk.NewDecWithPrec(1, 2)
+	msgEditValidator := NewMsgEditValidator(validatorAddr, Description{}, &newRate, nil)
+	res, err = handleMsgEditValidator(ctx, msgEditValidator, keeper)
+	require.True(t, types.ErrCommissionLTMinRate.Is(err))
+	require.Nil(t, res)
+}
+
+func TestMinCommissionRateRaisesExistingValidators(t *testing.T) {
+	initPower := int64(100)
+	initBond := sdk.TokensFromConsensusPower(10)
+	ctx, _, _, keeper, _ := keep.CreateTestInput(t, false, initPower)
+
+	createValidator := func(i int, commission CommissionRates) sdk.ValAddress {
+		validatorAddr := sdk.ValAddress(keep.Addrs[i])
+		msgCreateValidator := types.NewMsgCreateValidator(
+			validatorAddr, keep.PKs[i], sdk.NewCoin(sdk.DefaultBondDenom, initBond), Description{},
+			commission, sdk.OneInt(),
+		)
+		res, err := handleMsgCreateValidator(ctx, msgCreateValidator, keeper)
+		require.NoError(t, err)
+		require.NotNil(t, res)
+		return validatorAddr
+	}
+
+	// raised to the minimum, which its max rate and max change rate allow
+	raisedAddr := createValidator(0, NewCommissionRates(sdk.ZeroDec(), sdk.OneDec(), sdk.NewDecWithPrec(1, 1)))
+	// the raise exceeds the max change rate
+	maxChangeAddr := createValidator(1, NewCommissionRates(sdk.ZeroDec(), sdk.OneDec(), sdk.NewDecWithPrec(1, 2)))
+	// the raise exceeds the max rate
+	maxRateAddr := createValidator(2, NewCommissionRates(sdk.ZeroDec(), sdk.NewDecWithPrec(1, 2), sdk.NewDecWithPrec(1, 2)))
+
+	setMinRate := func(minRate sdk.Dec) {
+		params := keeper.GetParams(ctx)
+		params.MinCommissionRate = minRate
+		keeper.SetParams(ctx, params)
+	}
+	requireCommission := func(validatorAddr sdk.ValAddress, rate sdk.Dec, updateTime time.Time) {
+		validator, found := keeper.GetValidator(ctx, validatorAddr)
+		require.True(t, found)
+		require.Equal(t, rate, validator.Commission.Rate)
+		require.Equal(t, updateTime, validator.Commission.UpdateTime)
+	}
+	minCommissionRateEvents := func() (events sdk.Events) {
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == types.EventTypeMinCommissionRate {
+				events = append(events, event)
+			}
+		}
+		return events
+	}
+
+	createTime := ctx.BlockHeader().Time
+	minRate := sdk.NewDecWithPrec(5, 2)
+	setMinRate(minRate)
+
+	ctx = ctx.WithBlockTime(createTime.Add(48 * time.Hour)).WithEventManager(sdk.NewEventManager())
+	EndBlocker(ctx, keeper)
+
+	raiseTime := ctx.BlockHeader().Time
+	requireCommission(raisedAddr, minRate, raiseTime)
+	requireCommission(maxChangeAddr, sdk.ZeroDec(), createTime)
+	requireCommission(maxRateAddr, sdk.ZeroDec(), createTime)
+
+	events := minCommissionRateEvents()
+	require.Len(t, events, 3)
+	require.Len(t, events[0].Attributes, 2)
+	require.Equal(t, types.AttributeKeyReason, string(events[1].Attributes[2].Key))
+	require.Equal(t, types.AttributeKeyReason, string(events[2].Attributes[2].Key))
+
+	// a validator rejected by its max change rate can raise its commission
+	// towards the minimum itself
+	newRate := sdk.NewDecWithPrec(1, 2)
+	res, err := handleMsgEditValidator(ctx, NewMsgEditValidator(maxChangeAddr, Description{}, &newRate, nil), keeper)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	// raising the minimum again within 24 hours of the last change is rejected
+	// and keeps the update time, so it cannot work around the daily limit
+	setMinRate(sdk.NewDecWithPrec(8, 2))
+
+	ctx = ctx.WithBlockTime(raiseTime.Add(time.Hour)).WithEventManager(sdk.NewEventManager())
+	EndBlocker(ctx, keeper)
+
+	requireCommission(raisedAddr, minRate, raiseTime)
+	requireCommission(maxChangeAddr, newRate, raiseTime)
+
+	events = minCommissionRateEvents()
+	require.Len(t, events, 3)
+	for _, event := range events {
+		require.Equal(t, types.AttributeKeyReason, string(event.Attributes[2].Key))
+	}
+}
+
 func TestEditValidatorIncreaseMinSelfDelegationBeyondCurrentBond(t *testing.T) {
 	validatorAddr := sdk.ValAddress(keep.Addrs[0])
 
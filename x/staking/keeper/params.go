@@ -50,6 +50,14 @@ func (k Keeper) BondDenom(ctx sdk.Context) (res string) {
 	return
 }
 
+// MinCommissionRate - Minimum validator commission rate. Chains that never set
+// the parameter use DefaultMinCommissionRate.
+func (k Keeper) MinCommissionRate(ctx sdk.Context) (res sdk.Dec) {
+	res = types.DefaultMinCommissionRate
+	k.paramstore.GetIfExists(ctx, types.KeyMinCommissionRate, &res)
+	return
+}
+
 // Get all parameteras as types.Params
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 	return types.NewParams(
@@ -58,6 +66,7 @@ func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 		k.MaxEntries(ctx),
 		k.HistoricalEntries(ctx),
 		k.BondDenom(ctx),
+		k.MinCommissionRate(ctx),
 	)
 }
 
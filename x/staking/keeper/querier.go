@@ -8,8 +8,10 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -126,17 +128,32 @@ func queryValidatorDelegations(ctx sdk.Context, req abci.RequestQuery, k Keeper)
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
 	}
 
-	delegations := k.GetValidatorDelegations(ctx, params.ValidatorAddr)
-	delegationResps, err := delegationsToDelegationResponses(ctx, k, delegations)
+	// delegations are not indexed by validator, so all of them are filtered
+	delegations := types.Delegations{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DelegationKey)
+
+	pageRes, err := query.FilteredPaginate(store, params.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
+		delegation := types.MustUnmarshalDelegation(k.cdc, value)
+		if !delegation.GetValidatorAddr().Equals(params.ValidatorAddr) {
+			return false, nil
+		}
+
+		if accumulate {
+			delegations = append(delegations, delegation)
+		}
+
+		return true, nil
+	})
 	if err != nil {
-		return nil, err
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
 
-	if delegationResps == nil {
-		delegationResps = types.DelegationResponses{}
+	delegationResps, err := delegationsToDelegationResponses(ctx, k, delegations)
+	if err != nil {
+		return nil, err
 	}
 
-	res, err := codec.MarshalJSONIndent(types.ModuleCdc, delegationResps)
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, types.NewQueryDelegationsResponse(delegationResps, pageRes))
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
 	}
@@ -152,12 +169,20 @@ func queryValidatorUnbondingDelegations(ctx sdk.Context, req abci.RequestQuery,
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
 	}
 
-	unbonds := k.GetUnbondingDelegationsFromValidator(ctx, params.ValidatorAddr)
-	if unbonds == nil {
-		unbonds = types.UnbondingDelegations{}
+	unbonds := types.UnbondingDelegations{}
+	store := ctx.KVStore(k.storeKey)
+	indexPrefix := types.GetUBDsByValIndexKey(params.ValidatorAddr)
+
+	pageRes, err := query.Paginate(prefix.NewStore(store, indexPrefix), params.Pagination, func(key, _ []byte) error {
+		indexKey := append(append([]byte{}, indexPrefix...), key...)
+		unbonds = append(unbonds, types.MustUnmarshalUBD(k.cdc, store.Get(types.GetUBDKeyFromValIndexKey(indexKey))))
+		return nil
+	})
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
 
-	res, err := codec.MarshalJSONIndent(types.ModuleCdc, unbonds)
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, types.NewQueryUnbondingDelegationsResponse(unbonds, pageRes))
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
 	}
@@ -173,17 +198,23 @@ func queryDelegatorDelegations(ctx sdk.Context, req abci.RequestQuery, k Keeper)
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
 	}
 
-	delegations := k.GetAllDelegatorDelegations(ctx, params.DelegatorAddr)
-	delegationResps, err := delegationsToDelegationResponses(ctx, k, delegations)
+	delegations := types.Delegations{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetDelegationsKey(params.DelegatorAddr))
+
+	pageRes, err := query.Paginate(store, params.Pagination, func(_, value []byte) error {
+		delegations = append(delegations, types.MustUnmarshalDelegation(k.cdc, value))
+		return nil
+	})
 	if err != nil {
-		return nil, err
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
 
-	if delegationResps == nil {
-		delegationResps = types.DelegationResponses{}
+	delegationResps, err := delegationsToDelegationResponses(ctx, k, delegations)
+	if err != nil {
+		return nil, err
 	}
 
-	res, err := codec.MarshalJSONIndent(types.ModuleCdc, delegationResps)
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, types.NewQueryDelegationsResponse(delegationResps, pageRes))
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
 	}
@@ -199,12 +230,20 @@ func queryDelegatorUnbondingDelegations(ctx sdk.Context, req abci.RequestQuery,
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
 	}
 
-	unbondingDelegations := k.GetAllUnbondingDelegations(ctx, params.DelegatorAddr)
-	if unbondingDelegations == nil {
-		unbondingDelegations = types.UnbondingDelegations{}
+	unbondingDelegations := types.UnbondingDelegations{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetUBDsKey(params.DelegatorAddr))
+
+	pageRes, err := query.Paginate(store, params.Pagination, func(_, value []byte) error {
+		unbondingDelegations = append(unbondingDelegations, types.MustUnmarshalUBD(k.cdc, value))
+		return nil
+	})
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
 
-	res, err := codec.MarshalJSONIndent(types.ModuleCdc, unbondingDelegations)
+	res, err := codec.MarshalJSONIndent(
+		types.ModuleCdc, types.NewQueryUnbondingDelegationsResponse(unbondingDelegations, pageRes),
+	)
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
 	}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -295,9 +296,10 @@ func TestQueryDelegation(t *testing.T) {
 	res, err = queryDelegatorDelegations(ctx, query, keeper)
 	require.NoError(t, err)
 
-	var delegatorDelegations types.DelegationResponses
-	errRes = cdc.UnmarshalJSON(res, &delegatorDelegations)
+	var delegatorDelegationsRes types.QueryDelegationsResponse
+	errRes = cdc.UnmarshalJSON(res, &delegatorDelegationsRes)
 	require.NoError(t, errRes)
+	delegatorDelegations := delegatorDelegationsRes.Delegations
 	require.Len(t, delegatorDelegations, 1)
 	require.Equal(t, delegation.ValidatorAddress, delegatorDelegations[0].ValidatorAddress)
 	require.Equal(t, delegation.DelegatorAddress, delegatorDelegations[0].DelegatorAddress)
@@ -322,9 +324,10 @@ func TestQueryDelegation(t *testing.T) {
 	res, err = queryValidatorDelegations(ctx, query, keeper)
 	require.NoError(t, err)
 
-	var delegationsRes types.DelegationResponses
-	errRes = cdc.UnmarshalJSON(res, &delegationsRes)
+	var validatorDelegationsRes types.QueryDelegationsResponse
+	errRes = cdc.UnmarshalJSON(res, &validatorDelegationsRes)
 	require.NoError(t, errRes)
+	delegationsRes := validatorDelegationsRes.Delegations
 	require.Len(t, delegatorDelegations, 1)
 	require.Equal(t, delegation.ValidatorAddress, delegationsRes[0].ValidatorAddress)
 	require.Equal(t, delegation.DelegatorAddress, delegationsRes[0].DelegatorAddress)
@@ -372,10 +375,10 @@ func TestQueryDelegation(t *testing.T) {
 	res, err = queryDelegatorUnbondingDelegations(ctx, query, keeper)
 	require.NoError(t, err)
 
-	var delegatorUbds []types.UnbondingDelegation
+	var delegatorUbds types.QueryUnbondingDelegationsResponse
 	errRes = cdc.UnmarshalJSON(res, &delegatorUbds)
 	require.NoError(t, errRes)
-	require.Equal(t, unbond, delegatorUbds[0])
+	require.Equal(t, unbond, delegatorUbds.UnbondingDelegations[0])
 
 	// error unknown request
 	query.Data = bz[:len(bz)-1]
@@ -412,6 +415,75 @@ func TestQueryDelegation(t *testing.T) {
 	require.Len(t, redel.Entries, len(redelRes[0].Entries))
 }
 
+func TestQueryDelegationsPagination(t *testing.T) {
+	cdc := codec.New()
+	ctx, _, _, keeper, _ := CreateTestInput(t, false, 10000)
+
+	val1 := types.NewValidator(addrVal1, pk1, types.Description{})
+	keeper.SetValidator(ctx, val1)
+	keeper.SetValidatorByPowerIndex(ctx, val1)
+
+	val2 := types.NewValidator(addrVal2, pk2, types.Description{})
+	keeper.SetValidator(ctx, val2)
+	keeper.SetValidatorByPowerIndex(ctx, val2)
+
+	// delegate from five accounts to the first validator and from the first
+	// account to the second validator as well
+	delTokens := sdk.TokensFromConsensusPower(20)
+	for i := 0; i < 5; i++ {
+		val1, _ = keeper.GetValidator(ctx, addrVal1)
+		_, err := keeper.Delegate(ctx, Addrs[i], delTokens, sdk.Unbonded, val1, true)
+		require.NoError(t, err)
+	}
+	_, err := keeper.Delegate(ctx, Addrs[0], delTokens, sdk.Unbonded, val2, true)
+	require.NoError(t, err)
+
+	// walk through all validator delegations using the next key of each page
+	var (
+		delegations types.DelegationResponses
+		nextKey     []byte
+	)
+	for i := 0; i < 3; i++ {
+		bz, err := cdc.MarshalJSON(types.NewQueryValidatorPaginatedParams(addrVal1, query.NewPageRequest(nextKey, 0, 2, false)))
+		require.NoError(t, err)
+
+		res, err := queryValidatorDelegations(ctx, abci.RequestQuery{Data: bz}, keeper)
+		require.NoError(t, err)
+
+		var pageRes types.QueryDelegationsResponse
+		require.NoError(t, cdc.UnmarshalJSON(res, &pageRes))
+		require.LessOrEqual(t, len(pageRes.Delegations), 2)
+
+		delegations = append(delegations, pageRes.Delegations...)
+		nextKey = pageRes.Pagination.NextKey
+	}
+	require.Empty(t, nextKey)
+	require.Len(t, delegations, 5)
+	for _, del := range delegations {
+		require.Equal(t, addrVal1, del.ValidatorAddress)
+	}
+
+	// offset based pagination of the delegator delegations counts all of them
+	bz, err := cdc.MarshalJSON(types.NewQueryDelegatorPaginatedParams(Addrs[0], query.NewPageRequest(nil, 1, 1, true)))
+	require.NoError(t, err)
+
+	res, err := queryDelegatorDelegations(ctx, abci.RequestQuery{Data: bz}, keeper)
+	require.NoError(t, err)
+
+	var pageRes types.QueryDelegationsResponse
+	require.NoError(t, cdc.UnmarshalJSON(res, &pageRes))
+	require.Len(t, pageRes.Delegations, 1)
+	require.Equal(t, uint64(2), pageRes.Pagination.Total)
+	require.Empty(t, pageRes.Pagination.NextKey)
+
+	// key and offset cannot be used together
+	bz, err = cdc.MarshalJSON(types.NewQueryDelegatorPaginatedParams(Addrs[0], query.NewPageRequest([]byte{0x1}, 1, 1, false)))
+	require.NoError(t, err)
+
+	_, err = queryDelegatorDelegations(ctx, abci.RequestQuery{Data: bz}, keeper)
+	require.Error(t, err)
+}
+
 func TestQueryRedelegations(t *testing.T) {
 	cdc := codec.New()
 	ctx, _, _, keeper, _ := CreateTestInput(t, false, 10000)
@@ -545,8 +617,9 @@ func TestQueryUnbondingDelegation(t *testing.T) {
 	res, err = queryDelegatorUnbondingDelegations(ctx, query, keeper)
 	require.NoError(t, err)
 	require.NotNil(t, res)
-	var ubDels []types.UnbondingDelegation
-	require.NoError(t, cdc.UnmarshalJSON(res, &ubDels))
+	var ubDelsRes types.QueryUnbondingDelegationsResponse
+	require.NoError(t, cdc.UnmarshalJSON(res, &ubDelsRes))
+	ubDels := ubDelsRes.UnbondingDelegations
 	require.Equal(t, 1, len(ubDels))
 	require.Equal(t, addrAcc1, ubDels[0].DelegatorAddress)
 	require.Equal(t, val1.OperatorAddress, ubDels[0].ValidatorAddress)
@@ -564,8 +637,8 @@ func TestQueryUnbondingDelegation(t *testing.T) {
 	res, err = queryDelegatorUnbondingDelegations(ctx, query, keeper)
 	require.NoError(t, err)
 	require.NotNil(t, res)
-	require.NoError(t, cdc.UnmarshalJSON(res, &ubDels))
-	require.Equal(t, 0, len(ubDels))
+	require.NoError(t, cdc.UnmarshalJSON(res, &ubDelsRes))
+	require.Equal(t, 0, len(ubDelsRes.UnbondingDelegations))
 }
 
 func TestQueryHistoricalInfo(t *testing.T) {
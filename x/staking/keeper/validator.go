@@ -8,6 +8,7 @@ import (
 	gogotypes "github.com/gogo/protobuf/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -166,12 +167,64 @@ func (k Keeper) UpdateValidatorCommission(ctx sdk.Context,
 		return commission, err
 	}
 
+	// a validator below the minimum rate may still raise its commission
+	// towards it, as far as its max change rate allows
+	if minRate := k.MinCommissionRate(ctx); newRate.LT(minRate) && newRate.LTE(commission.Rate) {
+		return commission, sdkerrors.Wrapf(types.ErrCommissionLTMinRate, "cannot set validator commission to less than minimum rate of %s", minRate)
+	}
+
 	commission.Rate = newRate
 	commission.UpdateTime = blockTime
 
 	return commission, nil
 }
 
+// ApplyMinCommissionRate raises the commission of every validator whose rate
+// is below the MinCommissionRate parameter up to that minimum. It only runs in
+// blocks where the parameter was modified. A raise is subject to the same
+// limits as a commission edit: it cannot exceed the validator's max rate or max
+// change rate, nor happen within 24 hours of the last change. A validator whose
+// raise is rejected keeps its commission and has to raise it towards the
+// minimum itself. Each raise or rejection is reported by a min_commission_rate
+// event.
+func (k Keeper) ApplyMinCommissionRate(ctx sdk.Context) {
+	if !k.paramstore.Modified(ctx, types.KeyMinCommissionRate) {
+		return
+	}
+
+	minRate := k.MinCommissionRate(ctx)
+	blockTime := ctx.BlockHeader().Time
+
+	for _, validator := range k.GetAllValidators(ctx) {
+		if validator.Commission.Rate.GTE(minRate) {
+			continue
+		}
+
+		attributes := []sdk.Attribute{
+			sdk.NewAttribute(types.AttributeKeyValidator, validator.OperatorAddress.String()),
+		}
+
+		if err := validator.Commission.ValidateNewRate(minRate, blockTime); err != nil {
+			attributes = append(attributes,
+				sdk.NewAttribute(types.AttributeKeyCommissionRate, validator.Commission.Rate.String()),
+				sdk.NewAttribute(types.AttributeKeyReason, err.Error()),
+			)
+		} else {
+			validator.Commission.Rate = minRate
+			validator.Commission.UpdateTime = blockTime
+			k.SetValidator(ctx, validator)
+
+			attributes = append(attributes,
+				sdk.NewAttribute(types.AttributeKeyCommissionRate, minRate.String()),
+			)
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(types.EventTypeMinCommissionRate, attributes...),
+		)
+	}
+}
+
 // remove the validator record and associated indexes
 // except for the bonded validator index which is only handled in ApplyAndReturnTendermintUpdates
 func (k Keeper) RemoveValidator(ctx sdk.Context, address sdk.ValAddress) {
@@ -0,0 +1,12 @@
+package v039
+
+// Migrate accepts the exported x/staking params from v0.38, which lack a
+// minimum commission rate, and migrates them to v0.39 params by setting the
+// rate to its default. The remaining x/staking genesis state is unchanged.
+func Migrate(params Params) Params {
+	if params.MinCommissionRate.IsNil() {
+		params.MinCommissionRate = DefaultMinCommissionRate
+	}
+
+	return params
+}
@@ -0,0 +1,47 @@
+package v039_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v039staking "github.com/cosmos/cosmos-sdk/x/staking/legacy/v0_39"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrate(t *testing.T) {
+	v039Codec := codec.New()
+
+	var params v039staking.Params
+	v039Codec.MustUnmarshalJSON([]byte(`{
+  "unbonding_time": "1814400000000000",
+  "max_validators": 100,
+  "max_entries": 7,
+  "historical_entries": 3,
+  "bond_denom": "stake"
+}`), &params)
+
+	migrated := v039staking.Migrate(params)
+	require.Equal(t, 21*24*time.Hour, migrated.UnbondingTime)
+	require.Equal(t, uint32(3), migrated.HistoricalEntries)
+	require.True(t, sdk.ZeroDec().Equal(migrated.MinCommissionRate))
+
+	// an existing rate is kept
+	params.MinCommissionRate = sdk.NewDecWithPrec(5, 2)
+	require.Equal(t, params, v039staking.Migrate(params))
+
+	expected := `{
+  "unbonding_time": "1814400000000000",
+  "max_validators": 100,
+  "max_entries": 7,
+  "historical_entries": 3,
+  "bond_denom": "stake",
+  "min_commission_rate": "0.000000000000000000"
+}`
+
+	bz, err := v039Codec.MarshalJSONIndent(migrated, "", "  ")
+	require.NoError(t, err)
+	require.Equal(t, expected, string(bz))
+}
@@ -0,0 +1,29 @@
+package v039
+
+// DONTCOVER
+// nolint
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	ModuleName = "staking"
+)
+
+// DefaultMinCommissionRate is the minimum commission rate of chains that did
+// not set one.
+var DefaultMinCommissionRate = sdk.ZeroDec()
+
+type (
+	Params struct {
+		UnbondingTime     time.Duration `json:"unbonding_time" yaml:"unbonding_time"`
+		MaxValidators     uint32        `json:"max_validators,omitempty" yaml:"max_validators"`
+		MaxEntries        uint32        `json:"max_entries,omitempty" yaml:"max_entries"`
+		HistoricalEntries uint32        `json:"historical_entries,omitempty" yaml:"historical_entries"`
+		BondDenom         string        `json:"bond_denom,omitempty" yaml:"bond_denom"`
+		MinCommissionRate sdk.Dec       `json:"min_commission_rate" yaml:"min_commission_rate"`
+	}
+)
@@ -57,4 +57,5 @@ var (
 	ErrInvalidHistoricalInfo           = sdkerrors.Register(ModuleName, 44, "invalid historical info")
 	ErrNoHistoricalInfo                = sdkerrors.Register(ModuleName, 45, "no historical info found")
 	ErrEmptyValidatorPubKey            = sdkerrors.Register(ModuleName, 46, "empty validator public key")
+	ErrCommissionLTMinRate             = sdkerrors.Register(ModuleName, 47, "commission cannot be less than the min rate")
 )
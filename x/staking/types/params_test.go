@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestParamsEqual(t *testing.T) {
@@ -19,3 +21,20 @@ func TestParamsEqual(t *testing.T) {
 	ok = p1.Equal(p2)
 	require.False(t, ok)
 }
+
+func TestValidateMinCommissionRate(t *testing.T) {
+	p := DefaultParams()
+	require.NoError(t, p.Validate())
+
+	p.MinCommissionRate = sdk.NewDecWithPrec(5, 2)
+	require.NoError(t, p.Validate())
+
+	p.MinCommissionRate = sdk.NewDec(-1)
+	require.Error(t, p.Validate())
+
+	p.MinCommissionRate = sdk.NewDecWithPrec(11, 1)
+	require.Error(t, p.Validate())
+
+	p.MinCommissionRate = sdk.Dec{}
+	require.Error(t, p.Validate())
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 // query endpoints supported by the staking Querier
@@ -28,8 +29,12 @@ const (
 // - 'custom/staking/delegatorUnbondingDelegations'
 // - 'custom/staking/delegatorRedelegations'
 // - 'custom/staking/delegatorValidators'
+//
+// Pagination is only used by the delegatorDelegations and
+// delegatorUnbondingDelegations queries.
 type QueryDelegatorParams struct {
 	DelegatorAddr sdk.AccAddress
+	Pagination    *query.PageRequest
 }
 
 func NewQueryDelegatorParams(delegatorAddr sdk.AccAddress) QueryDelegatorParams {
@@ -38,13 +43,26 @@ func NewQueryDelegatorParams(delegatorAddr sdk.AccAddress) QueryDelegatorParams
 	}
 }
 
+// NewQueryDelegatorPaginatedParams creates a new QueryDelegatorParams instance
+// selecting the given page of a paginated query.
+func NewQueryDelegatorPaginatedParams(delegatorAddr sdk.AccAddress, pageReq *query.PageRequest) QueryDelegatorParams {
+	return QueryDelegatorParams{
+		DelegatorAddr: delegatorAddr,
+		Pagination:    pageReq,
+	}
+}
+
 // defines the params for the following queries:
 // - 'custom/staking/validator'
 // - 'custom/staking/validatorDelegations'
 // - 'custom/staking/validatorUnbondingDelegations'
 // - 'custom/staking/validatorRedelegations'
+//
+// Pagination is only used by the validatorDelegations and
+// validatorUnbondingDelegations queries.
 type QueryValidatorParams struct {
 	ValidatorAddr sdk.ValAddress
+	Pagination    *query.PageRequest
 }
 
 func NewQueryValidatorParams(validatorAddr sdk.ValAddress) QueryValidatorParams {
@@ -53,6 +71,15 @@ func NewQueryValidatorParams(validatorAddr sdk.ValAddress) QueryValidatorParams
 	}
 }
 
+// NewQueryValidatorPaginatedParams creates a new QueryValidatorParams instance
+// selecting the given page of a paginated query.
+func NewQueryValidatorPaginatedParams(validatorAddr sdk.ValAddress, pageReq *query.PageRequest) QueryValidatorParams {
+	return QueryValidatorParams{
+		ValidatorAddr: validatorAddr,
+		Pagination:    pageReq,
+	}
+}
+
 // defines the params for the following queries:
 // - 'custom/staking/delegation'
 // - 'custom/staking/unbondingDelegation'
@@ -108,3 +135,34 @@ type QueryHistoricalInfoParams struct {
 func NewQueryHistoricalInfoParams(height int64) QueryHistoricalInfoParams {
 	return QueryHistoricalInfoParams{height}
 }
+
+// QueryDelegationsResponse defines the response of the following queries:
+// - 'custom/staking/delegatorDelegations'
+// - 'custom/staking/validatorDelegations'
+type QueryDelegationsResponse struct {
+	Delegations DelegationResponses `json:"delegations" yaml:"delegations"`
+	Pagination  *query.PageResponse `json:"pagination" yaml:"pagination"`
+}
+
+// NewQueryDelegationsResponse creates a new QueryDelegationsResponse instance
+func NewQueryDelegationsResponse(delegations DelegationResponses, pageRes *query.PageResponse) QueryDelegationsResponse {
+	return QueryDelegationsResponse{Delegations: delegations, Pagination: pageRes}
+}
+
+// QueryUnbondingDelegationsResponse defines the response of the following
+// queries:
+// - 'custom/staking/delegatorUnbondingDelegations'
+// - 'custom/staking/validatorUnbondingDelegations'
+type QueryUnbondingDelegationsResponse struct {
+	UnbondingDelegations UnbondingDelegations `json:"unbonding_delegations" yaml:"unbonding_delegations"`
+	Pagination           *query.PageResponse  `json:"pagination" yaml:"pagination"`
+}
+
+// NewQueryUnbondingDelegationsResponse creates a new
+// QueryUnbondingDelegationsResponse instance
+func NewQueryUnbondingDelegationsResponse(
+	ubds UnbondingDelegations, pageRes *query.PageResponse,
+) QueryUnbondingDelegationsResponse {
+
+	return QueryUnbondingDelegationsResponse{UnbondingDelegations: ubds, Pagination: pageRes}
+}
@@ -1142,11 +1142,12 @@ func (m *Redelegation) GetEntries() []RedelegationEntry {
 
 // Params defines the parameters for the staking module.
 type Params struct {
-	UnbondingTime     time.Duration `protobuf:"bytes,1,opt,name=unbonding_time,json=unbondingTime,proto3,stdduration" json:"unbonding_time" yaml:"unbonding_time"`
-	MaxValidators     uint32        `protobuf:"varint,2,opt,name=max_validators,json=maxValidators,proto3" json:"max_validators,omitempty" yaml:"max_validators"`
-	MaxEntries        uint32        `protobuf:"varint,3,opt,name=max_entries,json=maxEntries,proto3" json:"max_entries,omitempty" yaml:"max_entries"`
-	HistoricalEntries uint32        `protobuf:"varint,4,opt,name=historical_entries,json=historicalEntries,proto3" json:"historical_entries,omitempty" yaml:"historical_entries"`
-	BondDenom         string        `protobuf:"bytes,5,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty" yaml:"bond_denom"`
+	UnbondingTime     time.Duration                          `protobuf:"bytes,1,opt,name=unbonding_time,json=unbondingTime,proto3,stdduration" json:"unbonding_time" yaml:"unbonding_time"`
+	MaxValidators     uint32                                 `protobuf:"varint,2,opt,name=max_validators,json=maxValidators,proto3" json:"max_validators,omitempty" yaml:"max_validators"`
+	MaxEntries        uint32                                 `protobuf:"varint,3,opt,name=max_entries,json=maxEntries,proto3" json:"max_entries,omitempty" yaml:"max_entries"`
+	HistoricalEntries uint32                                 `protobuf:"varint,4,opt,name=historical_entries,json=historicalEntries,proto3" json:"historical_entries,omitempty" yaml:"historical_entries"`
+	BondDenom         string                                 `protobuf:"bytes,5,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty" yaml:"bond_denom"`
+	MinCommissionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=min_commission_rate,json=minCommissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_commission_rate" yaml:"min_commission_rate"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -1242,112 +1243,114 @@ func init() {
 func init() { proto.RegisterFile("x/staking/types/types.proto", fileDescriptor_c669c0a3ee1b124c) }
 
 var fileDescriptor_c669c0a3ee1b124c = []byte{
-	// 1678 bytes of a gzipped FileDescriptorProto
+	// 1697 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcd, 0x6f, 0x23, 0x49,
-	0x15, 0x4f, 0xdb, 0x8e, 0x9d, 0x3c, 0x4f, 0xec, 0xa4, 0xa3, 0xc9, 0x78, 0xb2, 0xac, 0x3b, 0xf4,
+	0x15, 0x4f, 0xdb, 0x8e, 0x9d, 0x3c, 0x4f, 0xe2, 0xa4, 0xa3, 0xc9, 0x78, 0xb2, 0xac, 0x3b, 0xf4,
 	0xa2, 0x55, 0x84, 0x58, 0x5b, 0xd9, 0x45, 0x42, 0xca, 0x5e, 0x76, 0x1c, 0x27, 0x4a, 0x50, 0x82,
 	0x66, 0x3b, 0xb3, 0x39, 0xf0, 0x21, 0xab, 0xdc, 0x5d, 0x69, 0x17, 0x71, 0x77, 0x9b, 0xae, 0x72,
-	0xd6, 0x41, 0x5c, 0x91, 0x10, 0x12, 0x62, 0x2f, 0x48, 0x73, 0x1c, 0xf1, 0x0f, 0x70, 0x45, 0x70,
-	0xe1, 0x38, 0xdc, 0x46, 0x20, 0x21, 0xc4, 0xc1, 0xa0, 0x99, 0x0b, 0xe2, 0x04, 0x3e, 0x70, 0xe0,
-	0x84, 0xba, 0xaa, 0xfa, 0x23, 0x6d, 0x7b, 0xc6, 0xc9, 0x30, 0xc3, 0x48, 0x93, 0xcb, 0x8c, 0xeb,
-	0xf5, 0x7b, 0xbf, 0x57, 0xf5, 0xbe, 0xab, 0x02, 0xef, 0x0c, 0xea, 0x94, 0xa1, 0x33, 0xe2, 0xda,
-	0x75, 0x76, 0xd1, 0xc3, 0x54, 0xfc, 0x5b, 0xeb, 0xf9, 0x1e, 0xf3, 0xd4, 0x3b, 0xa6, 0x47, 0x1d,
-	0x8f, 0xb6, 0xa8, 0x75, 0x56, 0x1b, 0xd4, 0x24, 0x5f, 0xed, 0x7c, 0x6b, 0xfd, 0x7d, 0xd6, 0x21,
-	0xbe, 0xd5, 0xea, 0x21, 0x9f, 0x5d, 0xd4, 0x39, 0x6f, 0xdd, 0xf6, 0x6c, 0x2f, 0xfe, 0x25, 0x00,
-	0xd6, 0x3f, 0x1a, 0xe7, 0x63, 0xd8, 0xb5, 0xb0, 0xef, 0x10, 0x97, 0xd5, 0x51, 0xdb, 0x24, 0xe3,
-	0x5a, 0xd7, 0x35, 0xdb, 0xf3, 0xec, 0x2e, 0x16, 0xfc, 0xed, 0xfe, 0x69, 0x9d, 0x11, 0x07, 0x53,
-	0x86, 0x9c, 0x9e, 0x64, 0xa8, 0xa6, 0x19, 0xac, 0xbe, 0x8f, 0x18, 0xf1, 0x5c, 0xf9, 0x7d, 0x65,
-	0x0c, 0x53, 0xff, 0x57, 0x0e, 0xd4, 0x23, 0x6a, 0xef, 0xf8, 0x18, 0x31, 0x7c, 0x82, 0xba, 0xc4,
-	0x42, 0xcc, 0xf3, 0xd5, 0x43, 0x28, 0x5a, 0x98, 0x9a, 0x3e, 0xe9, 0x05, 0xe2, 0x15, 0x65, 0x43,
-	0xd9, 0x2c, 0x7e, 0xf8, 0x95, 0xda, 0x94, 0x63, 0xd7, 0x9a, 0x31, 0x6f, 0x23, 0xf7, 0x78, 0xa8,
-	0xcd, 0x19, 0x49, 0x71, 0xf5, 0x5b, 0x00, 0xa6, 0xe7, 0x38, 0x84, 0xd2, 0x00, 0x2c, 0xc3, 0xc1,
-	0x36, 0xa7, 0x82, 0xed, 0x44, 0xac, 0x06, 0x62, 0x98, 0x4a, 0xc0, 0x04, 0x82, 0xfa, 0x23, 0x58,
-	0x75, 0x88, 0xdb, 0xa2, 0xb8, 0x7b, 0xda, 0xb2, 0x70, 0x17, 0xdb, 0xfc, 0x90, 0x95, 0xec, 0x86,
-	0xb2, 0xb9, 0xd8, 0x38, 0x0c, 0xd8, 0xff, 0x32, 0xd4, 0xde, 0xb7, 0x09, 0xeb, 0xf4, 0xdb, 0x35,
-	0xd3, 0x73, 0xea, 0x42, 0x95, 0xfc, 0xef, 0x03, 0x6a, 0x9d, 0x49, 0x1b, 0x1c, 0xb8, 0x6c, 0x34,
-	0xd4, 0xd6, 0x2f, 0x90, 0xd3, 0xdd, 0xd6, 0x27, 0x40, 0xea, 0xc6, 0x8a, 0x43, 0xdc, 0x63, 0xdc,
-	0x3d, 0x6d, 0x46, 0x34, 0xf5, 0x87, 0xb0, 0x22, 0x39, 0x3c, 0xbf, 0x85, 0x2c, 0xcb, 0xc7, 0x94,
-	0x56, 0x72, 0x1b, 0xca, 0xe6, 0xad, 0xc6, 0xd1, 0x68, 0xa8, 0x55, 0x04, 0xda, 0x18, 0x8b, 0xfe,
-	0x9f, 0xa1, 0xf6, 0xc1, 0x0c, 0x7b, 0xba, 0x67, 0x9a, 0xf7, 0x84, 0x84, 0xb1, 0x1c, 0x81, 0x48,
-	0x4a, 0xa0, 0xfb, 0x3c, 0x74, 0x52, 0xa4, 0x7b, 0x3e, 0xad, 0x7b, 0x8c, 0x65, 0x56, 0xdd, 0x27,
-	0xa8, 0x1b, 0xe9, 0x8e, 0x40, 0x42, 0xdd, 0x6b, 0x90, 0xef, 0xf5, 0xdb, 0x67, 0xf8, 0xa2, 0x92,
-	0x0f, 0x0c, 0x6d, 0xc8, 0x95, 0x5a, 0x87, 0xf9, 0x73, 0xd4, 0xed, 0xe3, 0x4a, 0x81, 0x3b, 0x76,
-	0x35, 0xe9, 0x58, 0xee, 0x4e, 0x12, 0x06, 0x85, 0xe0, 0xd3, 0x7f, 0x9b, 0x85, 0xe5, 0x23, 0x6a,
-	0xef, 0x5a, 0x84, 0xbd, 0xaa, 0x88, 0xeb, 0x4d, 0xb2, 0x53, 0x86, 0xdb, 0x69, 0x67, 0x34, 0xd4,
-	0x4a, 0xc2, 0x4e, 0xff, 0x4b, 0xeb, 0x38, 0x50, 0x8e, 0x23, 0xb4, 0xe5, 0x23, 0x86, 0x65, 0x3c,
-	0x36, 0x67, 0x8c, 0xc5, 0x26, 0x36, 0x47, 0x43, 0x6d, 0x4d, 0xec, 0x2c, 0x05, 0xa5, 0x1b, 0x25,
-	0xf3, 0x52, 0x56, 0xa8, 0x83, 0xc9, 0x29, 0x90, 0xe3, 0x2a, 0xf7, 0x5f, 0x61, 0xf8, 0xeb, 0xbf,
-	0xce, 0x40, 0xf1, 0x88, 0xda, 0x92, 0x82, 0x27, 0xa7, 0x83, 0xf2, 0x7f, 0x4c, 0x87, 0xcc, 0xeb,
-	0x49, 0x87, 0x2d, 0xc8, 0x23, 0xc7, 0xeb, 0xbb, 0x8c, 0xfb, 0xf9, 0xb9, 0x71, 0x2f, 0x19, 0xf5,
-	0x3f, 0x66, 0x79, 0xb1, 0x6d, 0x60, 0x9b, 0xb8, 0x06, 0xb6, 0xde, 0x04, 0x0b, 0xfe, 0x58, 0x81,
-	0xdb, 0xb1, 0x7d, 0xa8, 0x6f, 0xa6, 0xcc, 0xf8, 0xe9, 0x68, 0xa8, 0x7d, 0x29, 0x6d, 0xc6, 0x04,
-	0xdb, 0x35, 0x4c, 0xb9, 0x1a, 0x01, 0x1d, 0xfb, 0xe6, 0xe4, 0x7d, 0x58, 0x94, 0x45, 0xfb, 0xc8,
-	0x4e, 0xdf, 0x47, 0x82, 0xed, 0xa5, 0xf6, 0xd1, 0xa4, 0x6c, 0xdc, 0xab, 0xb9, 0x59, 0xbd, 0xfa,
-	0x9b, 0x0c, 0x2c, 0x1d, 0x51, 0xfb, 0x33, 0xd7, 0xba, 0x49, 0x89, 0x2b, 0xa7, 0xc4, 0x2f, 0x14,
-	0x28, 0xed, 0x13, 0xca, 0x3c, 0x9f, 0x98, 0xa8, 0x7b, 0xe0, 0x9e, 0x7a, 0xea, 0xc7, 0x90, 0xef,
-	0x60, 0x64, 0x61, 0x5f, 0x36, 0x81, 0x77, 0x6b, 0xf1, 0x68, 0x54, 0x0b, 0x46, 0xa3, 0x9a, 0xd8,
-	0xca, 0x3e, 0x67, 0x0a, 0xf1, 0x84, 0x88, 0xfa, 0x09, 0xe4, 0xcf, 0x51, 0x97, 0x62, 0x56, 0xc9,
-	0x6c, 0x64, 0x37, 0x8b, 0x1f, 0xea, 0x53, 0x3b, 0x48, 0xd4, 0x7a, 0x42, 0x04, 0x21, 0xb7, 0x9d,
-	0xfb, 0xfb, 0x23, 0x4d, 0xd1, 0x7f, 0x95, 0x81, 0x72, 0x6a, 0x10, 0x51, 0x1b, 0x90, 0xe3, 0x75,
-	0x5d, 0xe1, 0x45, 0xb6, 0x76, 0x85, 0x39, 0xa3, 0x89, 0x4d, 0x83, 0xcb, 0xaa, 0xdf, 0x85, 0x05,
-	0x07, 0x0d, 0x44, 0x7f, 0xc8, 0x70, 0x9c, 0x7b, 0x57, 0xc3, 0x19, 0x0d, 0xb5, 0xb2, 0x2c, 0xd8,
-	0x12, 0x47, 0x37, 0x0a, 0x0e, 0x1a, 0xf0, 0xae, 0xd0, 0x83, 0x72, 0x40, 0x35, 0x3b, 0xc8, 0xb5,
-	0x71, 0xb2, 0x09, 0xed, 0x5f, 0x59, 0xc9, 0x5a, 0xac, 0x24, 0x01, 0xa7, 0x1b, 0x4b, 0x0e, 0x1a,
-	0xec, 0x70, 0x42, 0xa0, 0x71, 0x7b, 0xe1, 0xe1, 0x23, 0x6d, 0x8e, 0x5b, 0xec, 0x0f, 0x0a, 0x40,
-	0x6c, 0x31, 0xf5, 0x7b, 0xb0, 0x9c, 0x6a, 0x62, 0x54, 0xfa, 0x73, 0xf6, 0xc9, 0x6f, 0x21, 0xd8,
-	0xf5, 0x93, 0xa1, 0xa6, 0x18, 0x65, 0x33, 0xe5, 0x8b, 0xef, 0x40, 0xb1, 0xdf, 0xb3, 0x10, 0xc3,
-	0xad, 0x60, 0x08, 0x96, 0x33, 0xe5, 0x7a, 0x4d, 0x0c, 0xc0, 0xb5, 0x70, 0x00, 0xae, 0x3d, 0x08,
-	0x27, 0xe4, 0x46, 0x35, 0xc0, 0x1a, 0x0d, 0x35, 0x55, 0x9c, 0x2b, 0x21, 0xac, 0x7f, 0xf1, 0x57,
-	0x4d, 0x31, 0x40, 0x50, 0x02, 0x81, 0xc4, 0xa1, 0x7e, 0xaf, 0x40, 0x31, 0x31, 0x6a, 0xa8, 0x15,
-	0x28, 0x38, 0x9e, 0x4b, 0xce, 0x64, 0x70, 0x2e, 0x1a, 0xe1, 0x52, 0x5d, 0x87, 0x05, 0x62, 0x61,
-	0x97, 0x11, 0x76, 0x21, 0x1c, 0x6b, 0x44, 0xeb, 0x40, 0xea, 0x73, 0xdc, 0xa6, 0x24, 0x74, 0x87,
-	0x11, 0x2e, 0xd5, 0x3d, 0x58, 0xa6, 0xd8, 0xec, 0xfb, 0x84, 0x5d, 0xb4, 0x4c, 0xcf, 0x65, 0xc8,
-	0x64, 0xb2, 0x87, 0xbf, 0x33, 0x1a, 0x6a, 0x77, 0xc4, 0x5e, 0xd3, 0x1c, 0xba, 0x51, 0x0e, 0x49,
-	0x3b, 0x82, 0x12, 0x68, 0xb0, 0x30, 0x43, 0xa4, 0x2b, 0xa6, 0xc1, 0x45, 0x23, 0x5c, 0x26, 0xce,
-	0xf2, 0xbb, 0x02, 0x2c, 0xc6, 0xf3, 0xd6, 0xe7, 0xb0, 0xec, 0xf5, 0xb0, 0x3f, 0xa1, 0x44, 0x1d,
-	0xc6, 0x9a, 0xd3, 0x1c, 0xd7, 0xa8, 0x12, 0xe5, 0x10, 0x23, 0x2c, 0x12, 0x7b, 0x41, 0x60, 0xb8,
-	0x14, 0xbb, 0xb4, 0x4f, 0x5b, 0x72, 0xa0, 0xcc, 0xa4, 0x8f, 0x9c, 0xe6, 0xd0, 0x83, 0x08, 0x90,
-	0xa4, 0xfb, 0x62, 0xec, 0x5c, 0x83, 0xfc, 0xf7, 0x11, 0xe9, 0x62, 0x8b, 0xdb, 0x74, 0xc1, 0x90,
-	0x2b, 0xf5, 0x00, 0xf2, 0x94, 0x21, 0xd6, 0x17, 0x33, 0xf9, 0x7c, 0x63, 0x6b, 0xc6, 0x3d, 0x37,
-	0x3c, 0xd7, 0x3a, 0xe6, 0x82, 0x86, 0x04, 0x50, 0xf7, 0x20, 0xcf, 0xbc, 0x33, 0xec, 0x4a, 0xa3,
-	0x5e, 0x29, 0xe5, 0x0f, 0x5c, 0x66, 0x48, 0x69, 0x95, 0x41, 0x5c, 0xa7, 0x5b, 0xb4, 0x83, 0x7c,
-	0x4c, 0xc5, 0x0c, 0xdd, 0x38, 0xb8, 0x72, 0x5e, 0xde, 0x49, 0x37, 0x0f, 0x81, 0xa7, 0x1b, 0xe5,
-	0x88, 0x74, 0xcc, 0x29, 0xe9, 0x89, 0xba, 0xf0, 0x72, 0x13, 0xf5, 0x1e, 0x2c, 0xf7, 0xdd, 0xb6,
-	0xe7, 0x5a, 0xc4, 0xb5, 0x5b, 0x1d, 0x4c, 0xec, 0x0e, 0xab, 0x2c, 0x6c, 0x28, 0x9b, 0xd9, 0xa4,
-	0xdb, 0xd2, 0x1c, 0xba, 0x51, 0x8e, 0x48, 0xfb, 0x9c, 0xa2, 0x5a, 0x50, 0x8a, 0xb9, 0x78, 0xee,
-	0x2e, 0xbe, 0x30, 0x77, 0xbf, 0x2c, 0x73, 0xf7, 0x76, 0x5a, 0x4b, 0x9c, 0xbe, 0x4b, 0x11, 0x31,
-	0x10, 0x53, 0x0f, 0x2e, 0xdd, 0x38, 0x81, 0x6b, 0x78, 0x6f, 0x86, 0xba, 0x33, 0xfb, 0x65, 0xb3,
-	0xf8, 0x5a, 0x2e, 0x9b, 0xdb, 0xb7, 0x7e, 0xf2, 0x48, 0x9b, 0x8b, 0x52, 0xf8, 0xa7, 0x19, 0xc8,
-	0x37, 0x4f, 0xee, 0x23, 0xe2, 0xbf, 0xad, 0x33, 0x46, 0xa2, 0x9e, 0xed, 0x41, 0x41, 0xd8, 0x82,
-	0xaa, 0x1f, 0xc3, 0x7c, 0x2f, 0xf8, 0x51, 0x51, 0x78, 0xd3, 0xd7, 0xa6, 0x07, 0x39, 0x17, 0x08,
-	0xaf, 0xa3, 0x5c, 0x46, 0xff, 0x65, 0x16, 0xa0, 0x79, 0x72, 0xf2, 0xc0, 0x27, 0xbd, 0x2e, 0x66,
-	0x37, 0xd3, 0xf8, 0x9b, 0x33, 0x8d, 0x27, 0x9c, 0xfd, 0x00, 0x8a, 0xb1, 0x8f, 0xa8, 0xba, 0x0b,
-	0x0b, 0x4c, 0xfe, 0x96, 0x3e, 0x7f, 0xef, 0x39, 0x3e, 0x0f, 0xe5, 0xa4, 0xdf, 0x23, 0x51, 0xfd,
-	0x4f, 0x19, 0x80, 0x17, 0xbd, 0xec, 0xbc, 0x05, 0x73, 0xfb, 0x1e, 0xe4, 0x65, 0x57, 0xca, 0x5e,
-	0x6b, 0xb4, 0x95, 0xd2, 0x09, 0x77, 0xfd, 0x23, 0x03, 0xab, 0x9f, 0x85, 0x15, 0xf9, 0xc6, 0xc2,
-	0xea, 0xa7, 0x50, 0xc0, 0x2e, 0xf3, 0x09, 0x37, 0x71, 0x10, 0xae, 0x5b, 0x53, 0xc3, 0x75, 0x82,
-	0xd9, 0x76, 0x5d, 0xe6, 0x5f, 0xc8, 0xe0, 0x0d, 0x71, 0x12, 0xc6, 0xfe, 0x79, 0x16, 0x2a, 0xd3,
-	0xa4, 0xd4, 0x1d, 0x28, 0x9b, 0x3e, 0xe6, 0x84, 0xb0, 0x6d, 0x2b, 0xbc, 0x6d, 0xaf, 0x27, 0x5e,
-	0x9b, 0x2e, 0x33, 0xe8, 0x46, 0x29, 0xa4, 0xc8, 0xa6, 0x6d, 0xf3, 0xc7, 0xad, 0x20, 0x67, 0x02,
-	0xae, 0x19, 0x27, 0x6e, 0x5d, 0x76, 0xed, 0xf8, 0x49, 0x2b, 0x09, 0x20, 0xda, 0x76, 0x29, 0xa6,
-	0xf2, 0xbe, 0xfd, 0x03, 0x28, 0x13, 0x97, 0x30, 0x82, 0xba, 0xad, 0x36, 0xea, 0x22, 0xd7, 0xbc,
-	0xce, 0x05, 0x46, 0x34, 0x5a, 0xa9, 0x36, 0x05, 0xa7, 0x1b, 0x25, 0x49, 0x69, 0x08, 0x82, 0xba,
-	0x0f, 0x85, 0x50, 0x55, 0xee, 0x5a, 0x53, 0x5e, 0x28, 0x9e, 0xf0, 0xc8, 0xcf, 0xb2, 0xb0, 0x12,
-	0x3d, 0xf0, 0xdc, 0xb8, 0x62, 0x56, 0x57, 0x1c, 0x01, 0x88, 0x4a, 0x12, 0xf4, 0x92, 0x6b, 0x78,
-	0x23, 0xa8, 0x45, 0x8b, 0x02, 0xa1, 0x49, 0x59, 0xc2, 0x1f, 0xff, 0xcc, 0xc2, 0xad, 0xa4, 0x3f,
-	0x6e, 0x9a, 0xfc, 0x1b, 0xf4, 0xe4, 0xf6, 0xcd, 0xb8, 0x36, 0xe6, 0x78, 0x6d, 0xfc, 0xea, 0xd4,
-	0xda, 0x38, 0x96, 0x53, 0xd3, 0x8b, 0xe2, 0xbf, 0x33, 0x90, 0xbf, 0x8f, 0x7c, 0xe4, 0x50, 0xd5,
-	0x1c, 0xbb, 0x72, 0x88, 0x87, 0x88, 0xbb, 0x63, 0x19, 0xd3, 0x94, 0x7f, 0x2f, 0x7b, 0xc1, 0x8d,
-	0xe3, 0xe1, 0x84, 0x1b, 0xc7, 0x27, 0x50, 0x72, 0xd0, 0xa0, 0x15, 0x1d, 0x50, 0x78, 0x73, 0xa9,
-	0x71, 0x37, 0x46, 0xb9, 0xfc, 0x5d, 0x3c, 0xa5, 0x44, 0x17, 0x72, 0xaa, 0x7e, 0x03, 0x8a, 0x01,
-	0x47, 0xdc, 0x27, 0x02, 0xf1, 0xb5, 0xf8, 0xc9, 0x22, 0xf1, 0x51, 0x37, 0xc0, 0x41, 0x83, 0x5d,
-	0xb1, 0x50, 0x0f, 0x41, 0xed, 0x44, 0x4f, 0x68, 0xad, 0xd8, 0x96, 0x81, 0xfc, 0xbb, 0xa3, 0xa1,
-	0x76, 0x57, 0xc8, 0x8f, 0xf3, 0xe8, 0xc6, 0x4a, 0x4c, 0x0c, 0xd1, 0xbe, 0x0e, 0x10, 0x9c, 0xab,
-	0x65, 0x61, 0xd7, 0x73, 0xe4, 0xc5, 0xf7, 0xf6, 0x68, 0xa8, 0xad, 0x08, 0x94, 0xf8, 0x9b, 0x6e,
-	0x2c, 0x06, 0x8b, 0x66, 0xf0, 0x3b, 0x36, 0x7c, 0x63, 0xef, 0xf1, 0xd3, 0xaa, 0xf2, 0xe4, 0x69,
-	0x55, 0xf9, 0xdb, 0xd3, 0xaa, 0xf2, 0xc5, 0xb3, 0xea, 0xdc, 0x93, 0x67, 0xd5, 0xb9, 0x3f, 0x3f,
-	0xab, 0xce, 0x7d, 0xfb, 0x6b, 0xcf, 0x0d, 0x96, 0xd4, 0xdf, 0x5b, 0xdb, 0x79, 0xee, 0x95, 0x8f,
-	0xfe, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x62, 0xe9, 0xa4, 0xfa, 0x89, 0x1d, 0x00, 0x00,
+	0x26, 0x41, 0x5c, 0x91, 0x10, 0x12, 0x62, 0x2e, 0x48, 0x73, 0x1c, 0xf1, 0x0f, 0x70, 0x45, 0x70,
+	0xe1, 0x38, 0xdc, 0x46, 0x20, 0x21, 0xc4, 0xc1, 0xa0, 0x99, 0x0b, 0xe2, 0x04, 0x16, 0x27, 0x4e,
+	0xa8, 0xab, 0xaa, 0x3f, 0xd2, 0xb6, 0x27, 0x76, 0x86, 0x19, 0x46, 0x9a, 0x5c, 0x12, 0xd7, 0xf3,
+	0x7b, 0xbf, 0x57, 0xf5, 0x5e, 0xbd, 0xaf, 0x32, 0xbc, 0x77, 0x5e, 0xa3, 0x0c, 0x9d, 0x12, 0xd7,
+	0xae, 0xb1, 0x8b, 0x2e, 0xa6, 0xe2, 0x6f, 0xb5, 0xeb, 0x7b, 0xcc, 0x53, 0xef, 0x98, 0x1e, 0x75,
+	0x3c, 0xda, 0xa4, 0xd6, 0x69, 0xf5, 0xbc, 0x2a, 0xf9, 0xaa, 0x67, 0x9b, 0x6b, 0x1f, 0xb2, 0x36,
+	0xf1, 0xad, 0x66, 0x17, 0xf9, 0xec, 0xa2, 0xc6, 0x79, 0x6b, 0xb6, 0x67, 0x7b, 0xf1, 0x27, 0x01,
+	0xb0, 0xf6, 0xc9, 0x30, 0x1f, 0xc3, 0xae, 0x85, 0x7d, 0x87, 0xb8, 0xac, 0x86, 0x5a, 0x26, 0x19,
+	0xd6, 0xba, 0xa6, 0xd9, 0x9e, 0x67, 0x77, 0xb0, 0xe0, 0x6f, 0xf5, 0x4e, 0x6a, 0x8c, 0x38, 0x98,
+	0x32, 0xe4, 0x74, 0x25, 0x43, 0x25, 0xcd, 0x60, 0xf5, 0x7c, 0xc4, 0x88, 0xe7, 0xca, 0xef, 0x97,
+	0x87, 0x30, 0xf5, 0x7f, 0xe5, 0x40, 0x3d, 0xa4, 0xf6, 0xb6, 0x8f, 0x11, 0xc3, 0xc7, 0xa8, 0x43,
+	0x2c, 0xc4, 0x3c, 0x5f, 0x3d, 0x80, 0xa2, 0x85, 0xa9, 0xe9, 0x93, 0x6e, 0x20, 0x5e, 0x56, 0xd6,
+	0x95, 0x8d, 0xe2, 0xc7, 0x5f, 0xa9, 0x8e, 0x39, 0x76, 0xb5, 0x11, 0xf3, 0xd6, 0x73, 0x4f, 0xfb,
+	0xda, 0x8c, 0x91, 0x14, 0x57, 0xbf, 0x05, 0x60, 0x7a, 0x8e, 0x43, 0x28, 0x0d, 0xc0, 0x32, 0x1c,
+	0x6c, 0x63, 0x2c, 0xd8, 0x76, 0xc4, 0x6a, 0x20, 0x86, 0xa9, 0x04, 0x4c, 0x20, 0xa8, 0x3f, 0x82,
+	0x15, 0x87, 0xb8, 0x4d, 0x8a, 0x3b, 0x27, 0x4d, 0x0b, 0x77, 0xb0, 0xcd, 0x0f, 0x59, 0xce, 0xae,
+	0x2b, 0x1b, 0xf3, 0xf5, 0x83, 0x80, 0xfd, 0x2f, 0x7d, 0xed, 0x43, 0x9b, 0xb0, 0x76, 0xaf, 0x55,
+	0x35, 0x3d, 0xa7, 0x26, 0x54, 0xc9, 0x7f, 0x1f, 0x51, 0xeb, 0x54, 0xda, 0x60, 0xdf, 0x65, 0x83,
+	0xbe, 0xb6, 0x76, 0x81, 0x9c, 0xce, 0x96, 0x3e, 0x02, 0x52, 0x37, 0x96, 0x1d, 0xe2, 0x1e, 0xe1,
+	0xce, 0x49, 0x23, 0xa2, 0xa9, 0x3f, 0x84, 0x65, 0xc9, 0xe1, 0xf9, 0x4d, 0x64, 0x59, 0x3e, 0xa6,
+	0xb4, 0x9c, 0x5b, 0x57, 0x36, 0x6e, 0xd5, 0x0f, 0x07, 0x7d, 0xad, 0x2c, 0xd0, 0x86, 0x58, 0xf4,
+	0xff, 0xf4, 0xb5, 0x8f, 0x26, 0xd8, 0xd3, 0x3d, 0xd3, 0xbc, 0x27, 0x24, 0x8c, 0xa5, 0x08, 0x44,
+	0x52, 0x02, 0xdd, 0x67, 0xa1, 0x93, 0x22, 0xdd, 0xb3, 0x69, 0xdd, 0x43, 0x2c, 0x93, 0xea, 0x3e,
+	0x46, 0x9d, 0x48, 0x77, 0x04, 0x12, 0xea, 0x5e, 0x85, 0x7c, 0xb7, 0xd7, 0x3a, 0xc5, 0x17, 0xe5,
+	0x7c, 0x60, 0x68, 0x43, 0xae, 0xd4, 0x1a, 0xcc, 0x9e, 0xa1, 0x4e, 0x0f, 0x97, 0x0b, 0xdc, 0xb1,
+	0x2b, 0x49, 0xc7, 0x72, 0x77, 0x92, 0xf0, 0x52, 0x08, 0x3e, 0xfd, 0xb7, 0x59, 0x58, 0x3a, 0xa4,
+	0xf6, 0x8e, 0x45, 0xd8, 0xeb, 0xba, 0x71, 0xdd, 0x51, 0x76, 0xca, 0x70, 0x3b, 0x6d, 0x0f, 0xfa,
+	0xda, 0xa2, 0xb0, 0xd3, 0xff, 0xd2, 0x3a, 0x0e, 0x94, 0xe2, 0x1b, 0xda, 0xf4, 0x11, 0xc3, 0xf2,
+	0x3e, 0x36, 0x26, 0xbc, 0x8b, 0x0d, 0x6c, 0x0e, 0xfa, 0xda, 0xaa, 0xd8, 0x59, 0x0a, 0x4a, 0x37,
+	0x16, 0xcd, 0x4b, 0x51, 0xa1, 0x9e, 0x8f, 0x0e, 0x81, 0x1c, 0x57, 0xb9, 0xf7, 0x1a, 0xaf, 0xbf,
+	0xfe, 0xeb, 0x0c, 0x14, 0x0f, 0xa9, 0x2d, 0x29, 0x78, 0x74, 0x38, 0x28, 0xff, 0xc7, 0x70, 0xc8,
+	0xbc, 0x99, 0x70, 0xd8, 0x84, 0x3c, 0x72, 0xbc, 0x9e, 0xcb, 0xca, 0xd9, 0xab, 0xee, 0xbd, 0x64,
+	0xd4, 0xff, 0x98, 0xe5, 0xc9, 0xb6, 0x8e, 0x6d, 0xe2, 0x1a, 0xd8, 0x7a, 0x1b, 0x2c, 0xf8, 0x63,
+	0x05, 0x6e, 0xc7, 0xf6, 0xa1, 0xbe, 0x99, 0x32, 0xe3, 0xe7, 0x83, 0xbe, 0xf6, 0xa5, 0xb4, 0x19,
+	0x13, 0x6c, 0xd7, 0x30, 0xe5, 0x4a, 0x04, 0x74, 0xe4, 0x9b, 0xa3, 0xf7, 0x61, 0x51, 0x16, 0xed,
+	0x23, 0x3b, 0x7e, 0x1f, 0x09, 0xb6, 0x57, 0xda, 0x47, 0x83, 0xb2, 0x61, 0xaf, 0xe6, 0x26, 0xf5,
+	0xea, 0x6f, 0x32, 0xb0, 0x70, 0x48, 0xed, 0x2f, 0x5c, 0xeb, 0x26, 0x24, 0xa6, 0x0e, 0x89, 0x5f,
+	0x28, 0xb0, 0xb8, 0x47, 0x28, 0xf3, 0x7c, 0x62, 0xa2, 0xce, 0xbe, 0x7b, 0xe2, 0xa9, 0x9f, 0x42,
+	0xbe, 0x8d, 0x91, 0x85, 0x7d, 0x59, 0x04, 0xde, 0xaf, 0xc6, 0xad, 0x51, 0x35, 0x68, 0x8d, 0xaa,
+	0x62, 0x2b, 0x7b, 0x9c, 0x29, 0xc4, 0x13, 0x22, 0xea, 0x67, 0x90, 0x3f, 0x43, 0x1d, 0x8a, 0x59,
+	0x39, 0xb3, 0x9e, 0xdd, 0x28, 0x7e, 0xac, 0x8f, 0xad, 0x20, 0x51, 0xe9, 0x09, 0x11, 0x84, 0xdc,
+	0x56, 0xee, 0xef, 0x4f, 0x34, 0x45, 0xff, 0x55, 0x06, 0x4a, 0xa9, 0x46, 0x44, 0xad, 0x43, 0x8e,
+	0xe7, 0x75, 0x85, 0x27, 0xd9, 0xea, 0x14, 0x7d, 0x46, 0x03, 0x9b, 0x06, 0x97, 0x55, 0xbf, 0x0b,
+	0x73, 0x0e, 0x3a, 0x17, 0xf5, 0x21, 0xc3, 0x71, 0xee, 0x4d, 0x87, 0x33, 0xe8, 0x6b, 0x25, 0x99,
+	0xb0, 0x25, 0x8e, 0x6e, 0x14, 0x1c, 0x74, 0xce, 0xab, 0x42, 0x17, 0x4a, 0x01, 0xd5, 0x6c, 0x23,
+	0xd7, 0xc6, 0xc9, 0x22, 0xb4, 0x37, 0xb5, 0x92, 0xd5, 0x58, 0x49, 0x02, 0x4e, 0x37, 0x16, 0x1c,
+	0x74, 0xbe, 0xcd, 0x09, 0x81, 0xc6, 0xad, 0xb9, 0xc7, 0x4f, 0xb4, 0x19, 0x6e, 0xb1, 0x3f, 0x28,
+	0x00, 0xb1, 0xc5, 0xd4, 0xef, 0xc1, 0x52, 0xaa, 0x88, 0xd1, 0xb2, 0x32, 0x65, 0xe7, 0x37, 0x17,
+	0xec, 0xfa, 0x59, 0x5f, 0x53, 0x8c, 0x92, 0x99, 0xf2, 0xc5, 0x77, 0xa0, 0xd8, 0xeb, 0x5a, 0x88,
+	0xe1, 0x66, 0xd0, 0x04, 0xcb, 0x9e, 0x72, 0xad, 0x2a, 0x1a, 0xe0, 0x6a, 0xd8, 0x00, 0x57, 0x1f,
+	0x84, 0x1d, 0x72, 0xbd, 0x12, 0x60, 0x0d, 0xfa, 0x9a, 0x2a, 0xce, 0x95, 0x10, 0xd6, 0x1f, 0xfd,
+	0x55, 0x53, 0x0c, 0x10, 0x94, 0x40, 0x20, 0x71, 0xa8, 0xdf, 0x2b, 0x50, 0x4c, 0xb4, 0x1a, 0x6a,
+	0x19, 0x0a, 0x8e, 0xe7, 0x92, 0x53, 0x79, 0x39, 0xe7, 0x8d, 0x70, 0xa9, 0xae, 0xc1, 0x1c, 0xb1,
+	0xb0, 0xcb, 0x08, 0xbb, 0x10, 0x8e, 0x35, 0xa2, 0x75, 0x20, 0xf5, 0x10, 0xb7, 0x28, 0x09, 0xdd,
+	0x61, 0x84, 0x4b, 0x75, 0x17, 0x96, 0x28, 0x36, 0x7b, 0x3e, 0x61, 0x17, 0x4d, 0xd3, 0x73, 0x19,
+	0x32, 0x99, 0xac, 0xe1, 0xef, 0x0d, 0xfa, 0xda, 0x1d, 0xb1, 0xd7, 0x34, 0x87, 0x6e, 0x94, 0x42,
+	0xd2, 0xb6, 0xa0, 0x04, 0x1a, 0x2c, 0xcc, 0x10, 0xe9, 0x88, 0x6e, 0x70, 0xde, 0x08, 0x97, 0x89,
+	0xb3, 0xfc, 0xae, 0x00, 0xf3, 0x71, 0xbf, 0xf5, 0x10, 0x96, 0xbc, 0x2e, 0xf6, 0x47, 0xa4, 0xa8,
+	0x83, 0x58, 0x73, 0x9a, 0xe3, 0x1a, 0x59, 0xa2, 0x14, 0x62, 0x84, 0x49, 0x62, 0x37, 0xb8, 0x18,
+	0x2e, 0xc5, 0x2e, 0xed, 0xd1, 0xa6, 0x6c, 0x28, 0x33, 0xe9, 0x23, 0xa7, 0x39, 0x74, 0xa3, 0x14,
+	0x91, 0xee, 0x73, 0x4a, 0xd0, 0x8e, 0x7e, 0x1f, 0x91, 0x0e, 0xb6, 0xb8, 0x4d, 0xe7, 0x0c, 0xb9,
+	0x52, 0xf7, 0x21, 0x4f, 0x19, 0x62, 0x3d, 0xd1, 0x93, 0xcf, 0xd6, 0x37, 0x27, 0xdc, 0x73, 0xdd,
+	0x73, 0xad, 0x23, 0x2e, 0x68, 0x48, 0x00, 0x75, 0x17, 0xf2, 0xcc, 0x3b, 0xc5, 0xae, 0x34, 0xea,
+	0x54, 0x21, 0xbf, 0xef, 0x32, 0x43, 0x4a, 0xab, 0x0c, 0xe2, 0x3c, 0xdd, 0xa4, 0x6d, 0xe4, 0x63,
+	0x2a, 0x7a, 0xe8, 0xfa, 0xfe, 0xd4, 0x71, 0x79, 0x27, 0x5d, 0x3c, 0x04, 0x9e, 0x6e, 0x94, 0x22,
+	0xd2, 0x11, 0xa7, 0xa4, 0x3b, 0xea, 0xc2, 0xab, 0x75, 0xd4, 0xbb, 0xb0, 0xd4, 0x73, 0x5b, 0x9e,
+	0x6b, 0x11, 0xd7, 0x6e, 0xb6, 0x31, 0xb1, 0xdb, 0xac, 0x3c, 0xb7, 0xae, 0x6c, 0x64, 0x93, 0x6e,
+	0x4b, 0x73, 0xe8, 0x46, 0x29, 0x22, 0xed, 0x71, 0x8a, 0x6a, 0xc1, 0x62, 0xcc, 0xc5, 0x63, 0x77,
+	0xfe, 0xca, 0xd8, 0xfd, 0xb2, 0x8c, 0xdd, 0xdb, 0x69, 0x2d, 0x71, 0xf8, 0x2e, 0x44, 0xc4, 0x40,
+	0x4c, 0xdd, 0xbf, 0x34, 0x71, 0x02, 0xd7, 0xf0, 0xc1, 0x04, 0x79, 0x67, 0xf2, 0x61, 0xb3, 0xf8,
+	0x46, 0x86, 0xcd, 0xad, 0x5b, 0x3f, 0x79, 0xa2, 0xcd, 0x44, 0x21, 0xfc, 0xd3, 0x0c, 0xe4, 0x1b,
+	0xc7, 0xf7, 0x11, 0xf1, 0xdf, 0xd5, 0x1e, 0x23, 0x91, 0xcf, 0x76, 0xa1, 0x20, 0x6c, 0x41, 0xd5,
+	0x4f, 0x61, 0xb6, 0x1b, 0x7c, 0x28, 0x2b, 0xbc, 0xe8, 0x6b, 0xe3, 0x2f, 0x39, 0x17, 0x08, 0xc7,
+	0x51, 0x2e, 0xa3, 0xff, 0x32, 0x0b, 0xd0, 0x38, 0x3e, 0x7e, 0xe0, 0x93, 0x6e, 0x07, 0xb3, 0x9b,
+	0x6e, 0xfc, 0xed, 0xe9, 0xc6, 0x13, 0xce, 0x7e, 0x00, 0xc5, 0xd8, 0x47, 0x54, 0xdd, 0x81, 0x39,
+	0x26, 0x3f, 0x4b, 0x9f, 0x7f, 0xf0, 0x12, 0x9f, 0x87, 0x72, 0xd2, 0xef, 0x91, 0xa8, 0xfe, 0xa7,
+	0x0c, 0xc0, 0x55, 0x2f, 0x3b, 0xef, 0x40, 0xdf, 0xbe, 0x0b, 0x79, 0x59, 0x95, 0xb2, 0xd7, 0x6a,
+	0x6d, 0xa5, 0x74, 0xc2, 0x5d, 0xff, 0xc8, 0xc0, 0xca, 0x17, 0x61, 0x46, 0xbe, 0xb1, 0xb0, 0xfa,
+	0x39, 0x14, 0xb0, 0xcb, 0x7c, 0xc2, 0x4d, 0x1c, 0x5c, 0xd7, 0xcd, 0xb1, 0xd7, 0x75, 0x84, 0xd9,
+	0x76, 0x5c, 0xe6, 0x5f, 0xc8, 0xcb, 0x1b, 0xe2, 0x24, 0x8c, 0xfd, 0xf3, 0x2c, 0x94, 0xc7, 0x49,
+	0xa9, 0xdb, 0x50, 0x32, 0x7d, 0xcc, 0x09, 0x61, 0xd9, 0x56, 0x78, 0xd9, 0x5e, 0x4b, 0xbc, 0x36,
+	0x5d, 0x66, 0x08, 0x5e, 0x9b, 0x24, 0x45, 0x16, 0x6d, 0x9b, 0x3f, 0x6e, 0x05, 0x31, 0x13, 0x70,
+	0x4d, 0xd8, 0x71, 0xeb, 0xb2, 0x6a, 0xc7, 0x4f, 0x5a, 0x49, 0x00, 0x51, 0xb6, 0x17, 0x63, 0x2a,
+	0xaf, 0xdb, 0x3f, 0x80, 0x12, 0x71, 0x09, 0x23, 0xa8, 0xd3, 0x6c, 0xa1, 0x0e, 0x72, 0xcd, 0xeb,
+	0x0c, 0x30, 0xa2, 0xd0, 0x4a, 0xb5, 0x29, 0x38, 0xdd, 0x58, 0x94, 0x94, 0xba, 0x20, 0xa8, 0x7b,
+	0x50, 0x08, 0x55, 0xe5, 0xae, 0xd5, 0xe5, 0x85, 0xe2, 0x09, 0x8f, 0xfc, 0x2c, 0x0b, 0xcb, 0xd1,
+	0x03, 0xcf, 0x8d, 0x2b, 0x26, 0x75, 0xc5, 0x21, 0x80, 0xc8, 0x24, 0x41, 0x2d, 0x29, 0xe7, 0xae,
+	0x95, 0x8b, 0xe6, 0x05, 0x42, 0x83, 0xb2, 0x84, 0x3f, 0xfe, 0x99, 0x85, 0x5b, 0x49, 0x7f, 0xdc,
+	0x14, 0xf9, 0xb7, 0xe8, 0xc9, 0xed, 0x9b, 0x71, 0x6e, 0xcc, 0xf1, 0xdc, 0xf8, 0xd5, 0xb1, 0xb9,
+	0x71, 0x28, 0xa6, 0xc6, 0x27, 0xc5, 0x7f, 0x67, 0x21, 0x7f, 0x1f, 0xf9, 0xc8, 0xa1, 0xaa, 0x39,
+	0x34, 0x72, 0x88, 0x87, 0x88, 0xbb, 0x43, 0x11, 0xd3, 0x90, 0xbf, 0x97, 0x5d, 0x31, 0x71, 0x3c,
+	0x1e, 0x31, 0x71, 0x7c, 0x06, 0x8b, 0xc1, 0x5b, 0x49, 0x74, 0x40, 0xe1, 0xcd, 0x85, 0xfa, 0xdd,
+	0x18, 0xe5, 0xf2, 0xf7, 0xe2, 0x29, 0x25, 0x1a, 0xc8, 0xa9, 0xfa, 0x0d, 0x28, 0x06, 0x1c, 0x71,
+	0x9d, 0x08, 0xc4, 0x57, 0xe3, 0x27, 0x8b, 0xc4, 0x97, 0xba, 0x01, 0x0e, 0x3a, 0xdf, 0x11, 0x0b,
+	0xf5, 0x00, 0xd4, 0x76, 0xf4, 0x84, 0xd6, 0x8c, 0x6d, 0x19, 0xc8, 0xbf, 0x3f, 0xe8, 0x6b, 0x77,
+	0x85, 0xfc, 0x30, 0x8f, 0x6e, 0x2c, 0xc7, 0xc4, 0x10, 0xed, 0xeb, 0x00, 0xc1, 0xb9, 0x9a, 0x16,
+	0x76, 0x3d, 0x47, 0x0e, 0xbe, 0xb7, 0x07, 0x7d, 0x6d, 0x59, 0xa0, 0xc4, 0xdf, 0xe9, 0xc6, 0x7c,
+	0xb0, 0x68, 0x04, 0x9f, 0xc3, 0x29, 0x29, 0xfd, 0x13, 0x48, 0x7e, 0xea, 0x29, 0x49, 0x4c, 0xb9,
+	0x89, 0x29, 0x69, 0xe8, 0xa7, 0x90, 0x60, 0x4a, 0xba, 0xfc, 0x52, 0x14, 0xbb, 0xbd, 0xbe, 0xfb,
+	0xf4, 0x79, 0x45, 0x79, 0xf6, 0xbc, 0xa2, 0xfc, 0xed, 0x79, 0x45, 0x79, 0xf4, 0xa2, 0x32, 0xf3,
+	0xec, 0x45, 0x65, 0xe6, 0xcf, 0x2f, 0x2a, 0x33, 0xdf, 0xfe, 0xda, 0x4b, 0x95, 0xa7, 0x7e, 0xed,
+	0x6d, 0xe5, 0xf9, 0x9d, 0xf8, 0xe4, 0xbf, 0x03, 0x00, 0x11, 0xb5, 0xb3, 0xb6, 0x07, 0x1e, 0x00,
+	0x00,
 }
 
 func (this *HistoricalInfo) Equal(that interface{}) bool {
@@ -1789,6 +1792,9 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.BondDenom != that1.BondDenom {
 		return false
 	}
+	if !this.MinCommissionRate.Equal(that1.MinCommissionRate) {
+		return false
+	}
 	return true
 }
 func (m *MsgCreateValidator) Marshal() (dAtA []byte, err error) {
@@ -2844,6 +2850,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MinCommissionRate.Size()
+		i -= size
+		if _, err := m.MinCommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
 	if len(m.BondDenom) > 0 {
 		i -= len(m.BondDenom)
 		copy(dAtA[i:], m.BondDenom)
@@ -3307,6 +3323,8 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	l = m.MinCommissionRate.Size()
+	n += 1 + l + sovTypes(uint64(l))
 	return n
 }
 
@@ -6721,6 +6739,40 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.BondDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
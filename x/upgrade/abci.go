@@ -38,6 +38,13 @@ func BeginBlocker(k Keeper, ctx sdk.Context, _ abci.RequestBeginBlock) {
 			upgradeMsg := fmt.Sprintf("UPGRADE \"%s\" NEEDED at %s: %s", plan.Name, plan.DueAt(), plan.Info)
 			// We don't have an upgrade handler for this upgrade name, meaning this software is out of date so shutdown
 			ctx.Logger().Error(upgradeMsg)
+
+			// Write the upgrade info to disk so the upgraded binary knows which
+			// upgrade it is starting at and can apply its store migrations
+			if err := k.DumpUpgradeInfoToDisk(ctx.BlockHeight(), plan.Name); err != nil {
+				ctx.Logger().Error(fmt.Sprintf("failed to write upgrade info to disk: %s", err))
+			}
+
 			panic(upgradeMsg)
 		}
 		// We have an upgrade handler for this upgrade name, so apply the upgrade
@@ -2,6 +2,8 @@ package upgrade_test
 
 import (
 	"errors"
+	"io/ioutil"
+	"os"
 	"testing"
 	"time"
 
@@ -27,11 +29,33 @@ type TestSuite struct {
 	ctx     sdk.Context
 }
 
-var s TestSuite
+var (
+	s TestSuite
+
+	// testHome holds the home directories of all test apps and is removed once
+	// the tests have run
+	testHome string
+)
+
+func TestMain(m *testing.M) {
+	var err error
+	if testHome, err = ioutil.TempDir("", "upgrade_test"); err != nil {
+		panic(err)
+	}
+
+	code := m.Run()
+	os.RemoveAll(testHome)
+	os.Exit(code)
+}
 
 func setupTest(height int64, skip map[int64]bool) TestSuite {
 	db := dbm.NewMemDB()
-	app := simapp.NewSimApp(log.NewNopLogger(), db, nil, true, skip, 0)
+	homePath, err := ioutil.TempDir(testHome, "home")
+	if err != nil {
+		panic(err)
+	}
+
+	app := simapp.NewSimApp(log.NewNopLogger(), db, nil, true, skip, homePath, 0)
 	genesisState := simapp.NewDefaultGenesisState()
 	stateBytes, err := codec.MarshalJSONIndent(app.Codec(), genesisState)
 	if err != nil {
@@ -100,6 +124,27 @@ func TestDoHeightUpgrade(t *testing.T) {
 	VerifyDoUpgrade(t)
 }
 
+func TestDumpUpgradeInfoOnHalt(t *testing.T) {
+	s := setupTest(10, map[int64]bool{})
+
+	upgradeInfo, err := s.keeper.ReadUpgradeInfoFromDisk()
+	require.NoError(t, err)
+	require.Equal(t, upgrade.UpgradeInfo{}, upgradeInfo)
+
+	err = s.handler(s.ctx, upgrade.SoftwareUpgradeProposal{Title: "prop", Plan: upgrade.Plan{Name: "test", Height: s.ctx.BlockHeight() + 1}})
+	require.NoError(t, err)
+
+	t.Log("Verify the upgrade info is written to disk when halting for the upgrade")
+	newCtx := s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 1)
+	require.Panics(t, func() {
+		s.module.BeginBlock(newCtx, abci.RequestBeginBlock{Header: newCtx.BlockHeader()})
+	})
+
+	upgradeInfo, err = s.keeper.ReadUpgradeInfoFromDisk()
+	require.NoError(t, err)
+	require.Equal(t, upgrade.UpgradeInfo{Name: "test", Height: newCtx.BlockHeight()}, upgradeInfo)
+}
+
 func TestCanOverwriteScheduleUpgrade(t *testing.T) {
 	s := setupTest(10, map[int64]bool{})
 	t.Log("Can overwrite plan")
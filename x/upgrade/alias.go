@@ -18,6 +18,7 @@ const (
 	ProposalTypeCancelSoftwareUpgrade = types.ProposalTypeCancelSoftwareUpgrade
 	QueryCurrent                      = types.QueryCurrent
 	QueryApplied                      = types.QueryApplied
+	UpgradeInfoFileName               = types.UpgradeInfoFileName
 )
 
 var (
@@ -27,6 +28,7 @@ var (
 	NewSoftwareUpgradeProposal       = types.NewSoftwareUpgradeProposal
 	NewCancelSoftwareUpgradeProposal = types.NewCancelSoftwareUpgradeProposal
 	NewQueryAppliedParams            = types.NewQueryAppliedParams
+	UpgradeStoreLoader               = types.UpgradeStoreLoader
 	NewKeeper                        = keeper.NewKeeper
 	NewQuerier                       = keeper.NewQuerier
 )
@@ -37,5 +39,6 @@ type (
 	SoftwareUpgradeProposal       = types.SoftwareUpgradeProposal
 	CancelSoftwareUpgradeProposal = types.CancelSoftwareUpgradeProposal
 	QueryAppliedParams            = types.QueryAppliedParams
+	UpgradeInfo                   = types.UpgradeInfo
 	Keeper                        = keeper.Keeper
 )
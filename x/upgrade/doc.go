@@ -68,6 +68,25 @@ as well as providing the opportunity for the upgraded software to perform any ne
 (with the old binary) and applying the migration (with the new binary) are enforced in the state machine. Actually
 switching the binaries is an ops task and not handled inside the sdk / abci app.
 
+Store Migrations
+
+Upgrades that add, rename or delete store keys must migrate the multistore before the upgraded binary processes the
+upgrade block. When the node halts, the upgrade keeper writes the plan's name and height to
+<home>/data/upgrade-info.json. On start, the new binary reads it back and sets a store loader that applies the
+StoreUpgrades only when loading the version committed right before the upgrade height:
+	upgradeInfo, err := app.upgradeKeeper.ReadUpgradeInfoFromDisk()
+	if err != nil {
+		panic(err)
+	}
+
+	if upgradeInfo.Name == "my-fancy-upgrade" && !app.upgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+		storeUpgrades := store.StoreUpgrades{Renamed: []store.StoreRename{{OldKey: "foo", NewKey: "bar"}}}
+		app.SetStoreLoader(upgrade.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))
+	}
+
+Newly added store keys only need to be mounted. Since the loader is a no-op at any other height, it is safe to leave
+it in place across restarts.
+
 Halt Behavior
 
 Before halting the ABCI state machine in the BeginBlocker method, the upgrade module will log an error
@@ -2,7 +2,11 @@ package keeper
 
 import (
 	"encoding/binary"
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 
 	"github.com/tendermint/tendermint/libs/log"
 
@@ -14,15 +18,19 @@ import (
 )
 
 type Keeper struct {
+	homePath           string
 	skipUpgradeHeights map[int64]bool
 	storeKey           sdk.StoreKey
 	cdc                *codec.Codec
 	upgradeHandlers    map[string]types.UpgradeHandler
 }
 
-// NewKeeper constructs an upgrade Keeper
-func NewKeeper(skipUpgradeHeights map[int64]bool, storeKey sdk.StoreKey, cdc *codec.Codec) Keeper {
+// NewKeeper constructs an upgrade Keeper. The homePath is the node's home
+// directory, under which the pending upgrade information is written when the
+// node halts for an upgrade.
+func NewKeeper(skipUpgradeHeights map[int64]bool, storeKey sdk.StoreKey, cdc *codec.Codec, homePath string) Keeper {
 	return Keeper{
+		homePath:           homePath,
 		skipUpgradeHeights: skipUpgradeHeights,
 		storeKey:           storeKey,
 		cdc:                cdc,
@@ -130,3 +138,54 @@ func (k Keeper) ApplyUpgrade(ctx sdk.Context, plan types.Plan) {
 func (k Keeper) IsSkipHeight(height int64) bool {
 	return k.skipUpgradeHeights[height]
 }
+
+// GetUpgradeInfoPath returns the path of the upgrade information file, creating
+// the node's data directory if it does not exist yet.
+func (k Keeper) GetUpgradeInfoPath() (string, error) {
+	dir := filepath.Join(k.homePath, "data")
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, types.UpgradeInfoFileName), nil
+}
+
+// DumpUpgradeInfoToDisk writes the name and height of the upgrade the node is
+// halting for, so the upgraded binary can apply its store migrations on start.
+func (k Keeper) DumpUpgradeInfoToDisk(height int64, name string) error {
+	upgradeInfoPath, err := k.GetUpgradeInfoPath()
+	if err != nil {
+		return err
+	}
+
+	bz, err := json.Marshal(types.UpgradeInfo{Name: name, Height: height})
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(upgradeInfoPath, bz, 0600)
+}
+
+// ReadUpgradeInfoFromDisk returns the upgrade information written upon the
+// last upgrade halt. An empty UpgradeInfo is returned if there is none.
+func (k Keeper) ReadUpgradeInfoFromDisk() (types.UpgradeInfo, error) {
+	var upgradeInfo types.UpgradeInfo
+
+	upgradeInfoPath, err := k.GetUpgradeInfoPath()
+	if err != nil {
+		return upgradeInfo, err
+	}
+
+	bz, err := ioutil.ReadFile(upgradeInfoPath)
+	if os.IsNotExist(err) {
+		return upgradeInfo, nil
+	} else if err != nil {
+		return upgradeInfo, err
+	}
+
+	if err := json.Unmarshal(bz, &upgradeInfo); err != nil {
+		return upgradeInfo, fmt.Errorf("cannot parse upgrade info file %s: %w", upgradeInfoPath, err)
+	}
+
+	return upgradeInfo, nil
+}
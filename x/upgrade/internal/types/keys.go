@@ -12,6 +12,10 @@ const (
 
 	// QuerierKey is used to handle abci_query requests
 	QuerierKey = ModuleName
+
+	// UpgradeInfoFileName is the name of the file, under the node's data
+	// directory, the pending upgrade information is written to upon halting
+	UpgradeInfoFileName = "upgrade-info.json"
 )
 
 const (
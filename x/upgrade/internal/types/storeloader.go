@@ -0,0 +1,32 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// UpgradeInfo is the upgrade information the node writes to disk when it halts
+// because a scheduled upgrade has no handler in the running binary. The new
+// binary reads it on restart to decide which store migrations to apply.
+type UpgradeInfo struct {
+	Name   string `json:"name"`
+	Height int64  `json:"height"`
+}
+
+// UpgradeStoreLoader returns a StoreLoader that applies the given store upgrades
+// (renamed or deleted stores) only when the multistore is being loaded at the
+// version committed right before upgradeHeight, i.e. on the first start of the
+// upgraded binary. In every other case, or if there is nothing to upgrade, the
+// default store loader is used so the migration is never applied twice.
+func UpgradeStoreLoader(upgradeHeight int64, storeUpgrades *storetypes.StoreUpgrades) baseapp.StoreLoader {
+	return func(ms sdk.CommitMultiStore) error {
+		if upgradeHeight == ms.LastCommitID().Version+1 {
+			if storeUpgrades != nil && (len(storeUpgrades.Renamed) > 0 || len(storeUpgrades.Deleted) > 0) {
+				return ms.LoadLatestVersionAndUpgrade(storeUpgrades)
+			}
+		}
+
+		return baseapp.DefaultStoreLoader(ms)
+	}
+}
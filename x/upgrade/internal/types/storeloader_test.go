@@ -0,0 +1,83 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func initStore(t *testing.T, db dbm.DB, storeKey string, k, v []byte) {
+	rs := rootmulti.NewStore(db)
+	rs.SetPruning(storetypes.PruneNothing)
+	key := sdk.NewKVStoreKey(storeKey)
+	rs.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, rs.LoadLatestVersion())
+
+	kv := rs.GetKVStore(key)
+	kv.Set(k, v)
+	commitID := rs.Commit()
+	require.Equal(t, int64(1), commitID.Version)
+}
+
+func TestUpgradeStoreLoader(t *testing.T) {
+	upgrades := &storetypes.StoreUpgrades{
+		Renamed: []storetypes.StoreRename{{OldKey: "foo", NewKey: "bar"}},
+	}
+
+	cases := map[string]struct {
+		upgradeHeight int64
+		upgrades      *storetypes.StoreUpgrades
+		loadStoreKey  string
+		expectValue   bool
+	}{
+		"upgrade at next height applies store upgrades": {
+			upgradeHeight: 2,
+			upgrades:      upgrades,
+			loadStoreKey:  "bar",
+			expectValue:   true,
+		},
+		"upgrade at another height is ignored": {
+			upgradeHeight: 5,
+			upgrades:      upgrades,
+			loadStoreKey:  "bar",
+		},
+		"no store upgrades": {
+			upgradeHeight: 2,
+			upgrades:      &storetypes.StoreUpgrades{},
+			loadStoreKey:  "foo",
+			expectValue:   true,
+		},
+	}
+
+	k := []byte("key")
+	v := []byte("value")
+
+	for name, tc := range cases {
+		tc := tc
+
+		t.Run(name, func(t *testing.T) {
+			db := dbm.NewMemDB()
+			initStore(t, db, "foo", k, v)
+
+			rs := rootmulti.NewStore(db)
+			rs.SetPruning(storetypes.PruneNothing)
+			key := sdk.NewKVStoreKey(tc.loadStoreKey)
+			rs.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+
+			loader := UpgradeStoreLoader(tc.upgradeHeight, tc.upgrades)
+			require.NoError(t, loader(rs))
+			require.Equal(t, int64(1), rs.LastCommitID().Version)
+
+			if tc.expectValue {
+				require.Equal(t, v, rs.GetKVStore(key).Get(k))
+			} else {
+				require.Nil(t, rs.GetKVStore(key).Get(k))
+			}
+		})
+	}
+}